@@ -6,6 +6,14 @@ import (
 	"github.com/gqlc/graphql/token"
 )
 
+// Node is implemented by all AST nodes i.e. every message
+// with a position in the source.
+//
+type Node interface {
+	Pos() token.Pos // position of first character belonging to the node
+	End() token.Pos // position of first character immediately after the node
+}
+
 // Pos returns the starting position of the argument.
 func (a *Arg) Pos() token.Pos {
 	return a.Name.Pos()
@@ -18,6 +26,8 @@ func (a *Arg) End() token.Pos {
 		return v.BasicLit.End()
 	case *Arg_CompositeLit:
 		return v.CompositeLit.End()
+	case *Arg_Variable:
+		return v.Variable.End()
 	}
 	return token.NoPos
 }
@@ -245,6 +255,75 @@ func (x *EnumType) End() token.Pos      { return x.Values.End() }
 func (x *InputType) End() token.Pos     { return x.Fields.End() }
 func (x *DirectiveType) End() token.Pos { return x.Locs[len(x.Locs)-1].End() }
 
+// Pos and End implementations for document nodes.
+
+// Pos returns the position of the first top-level node of the document.
+func (x *Document) Pos() token.Pos {
+	pos := token.NoPos
+	if x.Doc != nil {
+		pos = x.Doc.Pos()
+	}
+	if len(x.Directives) > 0 {
+		pos = minPos(pos, x.Directives[0].Pos())
+	}
+	if len(x.Types) > 0 {
+		pos = minPos(pos, x.Types[0].Pos())
+	}
+	return pos
+}
+
+// End returns the end position of the last top-level node of the document.
+func (x *Document) End() (end token.Pos) {
+	if x.Doc != nil {
+		end = x.Doc.End()
+	}
+	if n := len(x.Directives); n > 0 && x.Directives[n-1].End() > end {
+		end = x.Directives[n-1].End()
+	}
+	if n := len(x.Types); n > 0 && x.Types[n-1].End() > end {
+		end = x.Types[n-1].End()
+	}
+	return
+}
+
+func minPos(a, b token.Pos) token.Pos {
+	if !a.IsValid() || b.IsValid() && b < a {
+		return b
+	}
+	return a
+}
+
+func (x *DocGroup) Pos() token.Pos {
+	if len(x.List) == 0 {
+		return token.NoPos
+	}
+	return x.List[0].Pos()
+}
+func (x *DocGroup) End() token.Pos {
+	if len(x.List) == 0 {
+		return token.NoPos
+	}
+	return x.List[len(x.List)-1].End()
+}
+func (x *DocGroup_Doc) Pos() token.Pos { return token.Pos(x.Char) }
+func (x *DocGroup_Doc) End() token.Pos { return token.Pos(int(x.Char) + len(x.Text)) }
+
+func (x *CallExpr) Pos() token.Pos    { return token.Pos(x.Lparen) }
+func (x *CallExpr) End() token.Pos    { return token.Pos(x.Rparen) + 1 }
+func (x *ObjLit_Pair) Pos() token.Pos { return x.Key.Pos() }
+func (x *ObjLit_Pair) End() token.Pos { return x.Val.End() }
+
+func (x *TypeDecl) Pos() token.Pos { return token.Pos(x.TokPos) }
+func (x *TypeDecl) End() token.Pos {
+	switch v := x.Spec.(type) {
+	case *TypeDecl_TypeSpec:
+		return v.TypeSpec.End()
+	case *TypeDecl_TypeExtSpec:
+		return v.TypeExtSpec.End()
+	}
+	return token.NoPos
+}
+
 // Pos and End implementations for spec nodes.
 
 func (s *TypeSpec) Pos() token.Pos          { return s.Name.Pos() }
@@ -278,6 +357,136 @@ func (s *TypeExtensionSpec) End() token.Pos {
 	return s.Type.End()
 }
 
+// Pos and End implementations for executable nodes.
+
+// Pos returns the position of the first definition of the document.
+func (x *ExecutableDocument) Pos() token.Pos {
+	pos := token.NoPos
+	if x.Doc != nil {
+		pos = x.Doc.Pos()
+	}
+	if len(x.Definitions) > 0 {
+		pos = minPos(pos, x.Definitions[0].Pos())
+	}
+	return pos
+}
+
+// End returns the end position of the last definition of the document.
+func (x *ExecutableDocument) End() (end token.Pos) {
+	if x.Doc != nil {
+		end = x.Doc.End()
+	}
+	if n := len(x.Definitions); n > 0 && x.Definitions[n-1].End() > end {
+		end = x.Definitions[n-1].End()
+	}
+	return
+}
+
+func (x *ExecutableDefinition) Pos() token.Pos {
+	switch v := x.Definition.(type) {
+	case *ExecutableDefinition_Operation:
+		return v.Operation.Pos()
+	case *ExecutableDefinition_Fragment:
+		return v.Fragment.Pos()
+	}
+	return token.NoPos
+}
+func (x *ExecutableDefinition) End() token.Pos {
+	switch v := x.Definition.(type) {
+	case *ExecutableDefinition_Operation:
+		return v.Operation.End()
+	case *ExecutableDefinition_Fragment:
+		return v.Fragment.End()
+	}
+	return token.NoPos
+}
+
+func (x *Variable) Pos() token.Pos     { return token.Pos(x.Dollar) }
+func (x *SelectionSet) Pos() token.Pos { return token.Pos(x.Opening) }
+func (x *FieldSelection) Pos() token.Pos {
+	if x.Alias != nil {
+		return x.Alias.Pos()
+	}
+	return x.Name.Pos()
+}
+func (x *FragmentSpread) Pos() token.Pos         { return token.Pos(x.Spread) }
+func (x *InlineFragment) Pos() token.Pos         { return token.Pos(x.Spread) }
+func (x *FragmentDefinition) Pos() token.Pos     { return token.Pos(x.Fragment) }
+func (x *VariableDefinition) Pos() token.Pos     { return x.Variable.Pos() }
+func (x *VariableDefinitionList) Pos() token.Pos { return token.Pos(x.Opening) }
+func (x *OperationDefinition) Pos() token.Pos {
+	if x.OpPos != 0 {
+		return token.Pos(x.OpPos)
+	}
+	return x.SelectionSet.Pos()
+}
+func (x *Selection) Pos() token.Pos {
+	switch v := x.Selection.(type) {
+	case *Selection_Field:
+		return v.Field.Pos()
+	case *Selection_FragmentSpread:
+		return v.FragmentSpread.Pos()
+	case *Selection_InlineFragment:
+		return v.InlineFragment.Pos()
+	}
+	return token.NoPos
+}
+
+func (x *Variable) End() token.Pos     { return x.Name.End() }
+func (x *SelectionSet) End() token.Pos { return token.Pos(x.Closing) + 1 }
+func (x *FieldSelection) End() token.Pos {
+	switch {
+	case x.SelectionSet != nil:
+		return x.SelectionSet.End()
+	case len(x.Directives) > 0:
+		return x.Directives[len(x.Directives)-1].End()
+	case x.Args != nil:
+		return token.Pos(x.Args.Rparen) + 1
+	}
+	return x.Name.End()
+}
+func (x *FragmentSpread) End() token.Pos {
+	if n := len(x.Directives); n > 0 {
+		return x.Directives[n-1].End()
+	}
+	return x.Name.End()
+}
+func (x *InlineFragment) End() token.Pos         { return x.SelectionSet.End() }
+func (x *FragmentDefinition) End() token.Pos     { return x.SelectionSet.End() }
+func (x *OperationDefinition) End() token.Pos    { return x.SelectionSet.End() }
+func (x *VariableDefinitionList) End() token.Pos { return token.Pos(x.Closing) + 1 }
+func (x *VariableDefinition) End() token.Pos {
+	if n := len(x.Directives); n > 0 {
+		return x.Directives[n-1].End()
+	}
+	switch v := x.Default.(type) {
+	case *VariableDefinition_BasicLit:
+		return v.BasicLit.End()
+	case *VariableDefinition_CompositeLit:
+		return v.CompositeLit.End()
+	}
+	switch v := x.Type.(type) {
+	case *VariableDefinition_Ident:
+		return v.Ident.End()
+	case *VariableDefinition_List:
+		return v.List.End()
+	case *VariableDefinition_NonNull:
+		return v.NonNull.End()
+	}
+	return token.NoPos
+}
+func (x *Selection) End() token.Pos {
+	switch v := x.Selection.(type) {
+	case *Selection_Field:
+		return v.Field.End()
+	case *Selection_FragmentSpread:
+		return v.FragmentSpread.End()
+	case *Selection_InlineFragment:
+		return v.InlineFragment.End()
+	}
+	return token.NoPos
+}
+
 // Text returns the text of the comment.
 // Documentation markers (#, ", """), the first space of a line comment, and
 // leading and trailing empty lines are removed. Multiple empty lines are
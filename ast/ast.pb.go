@@ -105,6 +105,34 @@ func (DirectiveLocation_Loc) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_37b5b141da493253, []int{15, 0}
 }
 
+type OperationDefinition_Operation int32
+
+const (
+	OperationDefinition_QUERY        OperationDefinition_Operation = 0
+	OperationDefinition_MUTATION     OperationDefinition_Operation = 1
+	OperationDefinition_SUBSCRIPTION OperationDefinition_Operation = 2
+)
+
+var OperationDefinition_Operation_name = map[int32]string{
+	0: "QUERY",
+	1: "MUTATION",
+	2: "SUBSCRIPTION",
+}
+
+var OperationDefinition_Operation_value = map[string]int32{
+	"QUERY":        0,
+	"MUTATION":     1,
+	"SUBSCRIPTION": 2,
+}
+
+func (x OperationDefinition_Operation) String() string {
+	return proto.EnumName(OperationDefinition_Operation_name, int32(x))
+}
+
+func (OperationDefinition_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{30, 0}
+}
+
 // Describes a complete GraphQL Document.
 type Document struct {
 	// document name
@@ -278,6 +306,7 @@ type Arg struct {
 	// Types that are valid to be assigned to Value:
 	//	*Arg_BasicLit
 	//	*Arg_CompositeLit
+	//	*Arg_Variable
 	Value                isArg_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -318,9 +347,13 @@ type Arg_BasicLit struct {
 type Arg_CompositeLit struct {
 	CompositeLit *CompositeLit `protobuf:"bytes,3,opt,name=compositeLit,proto3,oneof" json:"compositeLit,omitempty"`
 }
+type Arg_Variable struct {
+	Variable *Variable `protobuf:"bytes,4,opt,name=variable,proto3,oneof" json:"variable,omitempty"`
+}
 
 func (*Arg_BasicLit) isArg_Value()     {}
 func (*Arg_CompositeLit) isArg_Value() {}
+func (*Arg_Variable) isArg_Value()     {}
 
 func (m *Arg) GetValue() isArg_Value {
 	if m != nil {
@@ -350,17 +383,24 @@ func (m *Arg) GetCompositeLit() *CompositeLit {
 	return nil
 }
 
+func (m *Arg) GetVariable() *Variable {
+	if x, ok := m.GetValue().(*Arg_Variable); ok {
+		return x.Variable
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Arg) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Arg_BasicLit)(nil),
 		(*Arg_CompositeLit)(nil),
+		(*Arg_Variable)(nil),
 	}
 }
 
 // A Field represents a Field declaration in a GraphQL type declaration
 // or an argument declaration in an arguments declaration.
-//
 type Field struct {
 	Doc  *DocGroup       `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
 	Name *Ident          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -860,6 +900,7 @@ type CompositeLit struct {
 	//	*CompositeLit_BasicLit
 	//	*CompositeLit_ListLit
 	//	*CompositeLit_ObjLit
+	//	*CompositeLit_Variable
 	Value                isCompositeLit_Value `protobuf_oneof:"value"`
 	Closing              int64                `protobuf:"varint,5,opt,name=closing,proto3" json:"closing,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -904,10 +945,14 @@ type CompositeLit_ListLit struct {
 type CompositeLit_ObjLit struct {
 	ObjLit *ObjLit `protobuf:"bytes,4,opt,name=objLit,proto3,oneof" json:"objLit,omitempty"`
 }
+type CompositeLit_Variable struct {
+	Variable *Variable `protobuf:"bytes,6,opt,name=variable,proto3,oneof" json:"variable,omitempty"`
+}
 
 func (*CompositeLit_BasicLit) isCompositeLit_Value() {}
 func (*CompositeLit_ListLit) isCompositeLit_Value()  {}
 func (*CompositeLit_ObjLit) isCompositeLit_Value()   {}
+func (*CompositeLit_Variable) isCompositeLit_Value() {}
 
 func (m *CompositeLit) GetValue() isCompositeLit_Value {
 	if m != nil {
@@ -944,6 +989,13 @@ func (m *CompositeLit) GetObjLit() *ObjLit {
 	return nil
 }
 
+func (m *CompositeLit) GetVariable() *Variable {
+	if x, ok := m.GetValue().(*CompositeLit_Variable); ok {
+		return x.Variable
+	}
+	return nil
+}
+
 func (m *CompositeLit) GetClosing() int64 {
 	if m != nil {
 		return m.Closing
@@ -957,6 +1009,7 @@ func (*CompositeLit) XXX_OneofWrappers() []interface{} {
 		(*CompositeLit_BasicLit)(nil),
 		(*CompositeLit_ListLit)(nil),
 		(*CompositeLit_ObjLit)(nil),
+		(*CompositeLit_Variable)(nil),
 	}
 }
 
@@ -1685,6 +1738,8 @@ func (m *ObjectType) GetFields() *FieldList {
 // InterfaceType represents an interface type declaration.
 type InterfaceType struct {
 	Interface            int64      `protobuf:"varint,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	ImplPos              int64      `protobuf:"varint,3,opt,name=implPos,proto3" json:"implPos,omitempty"`
+	Interfaces           []*Ident   `protobuf:"bytes,4,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
 	Fields               *FieldList `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
@@ -1722,6 +1777,20 @@ func (m *InterfaceType) GetInterface() int64 {
 	return 0
 }
 
+func (m *InterfaceType) GetImplPos() int64 {
+	if m != nil {
+		return m.ImplPos
+	}
+	return 0
+}
+
+func (m *InterfaceType) GetInterfaces() []*Ident {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
 func (m *InterfaceType) GetFields() *FieldList {
 	if m != nil {
 		return m.Fields
@@ -1874,6 +1943,8 @@ func (m *InputType) GetFields() *InputValueList {
 type DirectiveType struct {
 	Directive            int64                `protobuf:"varint,1,opt,name=directive,proto3" json:"directive,omitempty"`
 	Args                 *InputValueList      `protobuf:"bytes,2,opt,name=args,proto3" json:"args,omitempty"`
+	Repeatable           bool                 `protobuf:"varint,5,opt,name=repeatable,proto3" json:"repeatable,omitempty"`
+	RepeatablePos        int64                `protobuf:"varint,6,opt,name=repeatablePos,proto3" json:"repeatablePos,omitempty"`
 	OnPos                int64                `protobuf:"varint,3,opt,name=onPos,proto3" json:"onPos,omitempty"`
 	Locs                 []*DirectiveLocation `protobuf:"bytes,4,rep,name=locs,proto3" json:"locs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -1919,6 +1990,20 @@ func (m *DirectiveType) GetArgs() *InputValueList {
 	return nil
 }
 
+func (m *DirectiveType) GetRepeatable() bool {
+	if m != nil {
+		return m.Repeatable
+	}
+	return false
+}
+
+func (m *DirectiveType) GetRepeatablePos() int64 {
+	if m != nil {
+		return m.RepeatablePos
+	}
+	return 0
+}
+
 func (m *DirectiveType) GetOnPos() int64 {
 	if m != nil {
 		return m.OnPos
@@ -2262,8 +2347,904 @@ func (*TypeDecl) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ExecutableDocument describes a complete GraphQL executable document i.e.
+// a set of operations and fragments.
+type ExecutableDocument struct {
+	// document name
+	Name string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Doc  *DocGroup `protobuf:"bytes,2,opt,name=doc,proto3" json:"doc,omitempty"`
+	// All top-level definitions in this file, in source order.
+	Definitions          []*ExecutableDefinition `protobuf:"bytes,3,rep,name=definitions,proto3" json:"definitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ExecutableDocument) Reset()         { *m = ExecutableDocument{} }
+func (m *ExecutableDocument) String() string { return proto.CompactTextString(m) }
+func (*ExecutableDocument) ProtoMessage()    {}
+func (*ExecutableDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{28}
+}
+func (m *ExecutableDocument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutableDocument.Unmarshal(m, b)
+}
+func (m *ExecutableDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutableDocument.Marshal(b, m, deterministic)
+}
+func (m *ExecutableDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutableDocument.Merge(m, src)
+}
+func (m *ExecutableDocument) XXX_Size() int {
+	return xxx_messageInfo_ExecutableDocument.Size(m)
+}
+func (m *ExecutableDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutableDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutableDocument proto.InternalMessageInfo
+
+func (m *ExecutableDocument) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExecutableDocument) GetDoc() *DocGroup {
+	if m != nil {
+		return m.Doc
+	}
+	return nil
+}
+
+func (m *ExecutableDocument) GetDefinitions() []*ExecutableDefinition {
+	if m != nil {
+		return m.Definitions
+	}
+	return nil
+}
+
+// ExecutableDefinition represents either an operation or fragment definition.
+type ExecutableDefinition struct {
+	Doc *DocGroup `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	// Types that are valid to be assigned to Definition:
+	//	*ExecutableDefinition_Operation
+	//	*ExecutableDefinition_Fragment
+	Definition           isExecutableDefinition_Definition `protobuf_oneof:"definition"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *ExecutableDefinition) Reset()         { *m = ExecutableDefinition{} }
+func (m *ExecutableDefinition) String() string { return proto.CompactTextString(m) }
+func (*ExecutableDefinition) ProtoMessage()    {}
+func (*ExecutableDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{29}
+}
+func (m *ExecutableDefinition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutableDefinition.Unmarshal(m, b)
+}
+func (m *ExecutableDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutableDefinition.Marshal(b, m, deterministic)
+}
+func (m *ExecutableDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutableDefinition.Merge(m, src)
+}
+func (m *ExecutableDefinition) XXX_Size() int {
+	return xxx_messageInfo_ExecutableDefinition.Size(m)
+}
+func (m *ExecutableDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutableDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutableDefinition proto.InternalMessageInfo
+
+type isExecutableDefinition_Definition interface {
+	isExecutableDefinition_Definition()
+}
+
+type ExecutableDefinition_Operation struct {
+	Operation *OperationDefinition `protobuf:"bytes,2,opt,name=operation,proto3,oneof" json:"operation,omitempty"`
+}
+type ExecutableDefinition_Fragment struct {
+	Fragment *FragmentDefinition `protobuf:"bytes,3,opt,name=fragment,proto3,oneof" json:"fragment,omitempty"`
+}
+
+func (*ExecutableDefinition_Operation) isExecutableDefinition_Definition() {}
+func (*ExecutableDefinition_Fragment) isExecutableDefinition_Definition()  {}
+
+func (m *ExecutableDefinition) GetDefinition() isExecutableDefinition_Definition {
+	if m != nil {
+		return m.Definition
+	}
+	return nil
+}
+
+func (m *ExecutableDefinition) GetDoc() *DocGroup {
+	if m != nil {
+		return m.Doc
+	}
+	return nil
+}
+
+func (m *ExecutableDefinition) GetOperation() *OperationDefinition {
+	if x, ok := m.GetDefinition().(*ExecutableDefinition_Operation); ok {
+		return x.Operation
+	}
+	return nil
+}
+
+func (m *ExecutableDefinition) GetFragment() *FragmentDefinition {
+	if x, ok := m.GetDefinition().(*ExecutableDefinition_Fragment); ok {
+		return x.Fragment
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ExecutableDefinition) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ExecutableDefinition_Operation)(nil),
+		(*ExecutableDefinition_Fragment)(nil),
+	}
+}
+
+// OperationDefinition represents a query, mutation or subscription.
+type OperationDefinition struct {
+	OpPos                int64                         `protobuf:"varint,1,opt,name=opPos,proto3" json:"opPos,omitempty"`
+	Op                   OperationDefinition_Operation `protobuf:"varint,2,opt,name=op,proto3,enum=gqlc.protobuf.OperationDefinition_Operation" json:"op,omitempty"`
+	Name                 *Ident                        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Variables            *VariableDefinitionList       `protobuf:"bytes,4,opt,name=variables,proto3" json:"variables,omitempty"`
+	Directives           []*DirectiveLit               `protobuf:"bytes,5,rep,name=directives,proto3" json:"directives,omitempty"`
+	SelectionSet         *SelectionSet                 `protobuf:"bytes,6,opt,name=selectionSet,proto3" json:"selectionSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *OperationDefinition) Reset()         { *m = OperationDefinition{} }
+func (m *OperationDefinition) String() string { return proto.CompactTextString(m) }
+func (*OperationDefinition) ProtoMessage()    {}
+func (*OperationDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{30}
+}
+func (m *OperationDefinition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperationDefinition.Unmarshal(m, b)
+}
+func (m *OperationDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperationDefinition.Marshal(b, m, deterministic)
+}
+func (m *OperationDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationDefinition.Merge(m, src)
+}
+func (m *OperationDefinition) XXX_Size() int {
+	return xxx_messageInfo_OperationDefinition.Size(m)
+}
+func (m *OperationDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationDefinition proto.InternalMessageInfo
+
+func (m *OperationDefinition) GetOpPos() int64 {
+	if m != nil {
+		return m.OpPos
+	}
+	return 0
+}
+
+func (m *OperationDefinition) GetOp() OperationDefinition_Operation {
+	if m != nil {
+		return m.Op
+	}
+	return OperationDefinition_QUERY
+}
+
+func (m *OperationDefinition) GetName() *Ident {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *OperationDefinition) GetVariables() *VariableDefinitionList {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *OperationDefinition) GetDirectives() []*DirectiveLit {
+	if m != nil {
+		return m.Directives
+	}
+	return nil
+}
+
+func (m *OperationDefinition) GetSelectionSet() *SelectionSet {
+	if m != nil {
+		return m.SelectionSet
+	}
+	return nil
+}
+
+// FragmentDefinition represents a named fragment definition.
+type FragmentDefinition struct {
+	Fragment             int64           `protobuf:"varint,1,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	Name                 *Ident          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OnPos                int64           `protobuf:"varint,3,opt,name=onPos,proto3" json:"onPos,omitempty"`
+	TypeCond             *Ident          `protobuf:"bytes,4,opt,name=typeCond,proto3" json:"typeCond,omitempty"`
+	Directives           []*DirectiveLit `protobuf:"bytes,5,rep,name=directives,proto3" json:"directives,omitempty"`
+	SelectionSet         *SelectionSet   `protobuf:"bytes,6,opt,name=selectionSet,proto3" json:"selectionSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FragmentDefinition) Reset()         { *m = FragmentDefinition{} }
+func (m *FragmentDefinition) String() string { return proto.CompactTextString(m) }
+func (*FragmentDefinition) ProtoMessage()    {}
+func (*FragmentDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{31}
+}
+func (m *FragmentDefinition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FragmentDefinition.Unmarshal(m, b)
+}
+func (m *FragmentDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FragmentDefinition.Marshal(b, m, deterministic)
+}
+func (m *FragmentDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FragmentDefinition.Merge(m, src)
+}
+func (m *FragmentDefinition) XXX_Size() int {
+	return xxx_messageInfo_FragmentDefinition.Size(m)
+}
+func (m *FragmentDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_FragmentDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FragmentDefinition proto.InternalMessageInfo
+
+func (m *FragmentDefinition) GetFragment() int64 {
+	if m != nil {
+		return m.Fragment
+	}
+	return 0
+}
+
+func (m *FragmentDefinition) GetName() *Ident {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *FragmentDefinition) GetOnPos() int64 {
+	if m != nil {
+		return m.OnPos
+	}
+	return 0
+}
+
+func (m *FragmentDefinition) GetTypeCond() *Ident {
+	if m != nil {
+		return m.TypeCond
+	}
+	return nil
+}
+
+func (m *FragmentDefinition) GetDirectives() []*DirectiveLit {
+	if m != nil {
+		return m.Directives
+	}
+	return nil
+}
+
+func (m *FragmentDefinition) GetSelectionSet() *SelectionSet {
+	if m != nil {
+		return m.SelectionSet
+	}
+	return nil
+}
+
+// VariableDefinition represents a variable declared by an operation.
+type VariableDefinition struct {
+	Variable *Variable `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
+	// type
+	//
+	// Types that are valid to be assigned to Type:
+	//	*VariableDefinition_Ident
+	//	*VariableDefinition_List
+	//	*VariableDefinition_NonNull
+	Type isVariableDefinition_Type `protobuf_oneof:"type"`
+	// default value; or nil
+	//
+	// Types that are valid to be assigned to Default:
+	//	*VariableDefinition_BasicLit
+	//	*VariableDefinition_CompositeLit
+	Default              isVariableDefinition_Default `protobuf_oneof:"default"`
+	Directives           []*DirectiveLit              `protobuf:"bytes,7,rep,name=directives,proto3" json:"directives,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *VariableDefinition) Reset()         { *m = VariableDefinition{} }
+func (m *VariableDefinition) String() string { return proto.CompactTextString(m) }
+func (*VariableDefinition) ProtoMessage()    {}
+func (*VariableDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{32}
+}
+func (m *VariableDefinition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VariableDefinition.Unmarshal(m, b)
+}
+func (m *VariableDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VariableDefinition.Marshal(b, m, deterministic)
+}
+func (m *VariableDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VariableDefinition.Merge(m, src)
+}
+func (m *VariableDefinition) XXX_Size() int {
+	return xxx_messageInfo_VariableDefinition.Size(m)
+}
+func (m *VariableDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_VariableDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VariableDefinition proto.InternalMessageInfo
+
+type isVariableDefinition_Type interface {
+	isVariableDefinition_Type()
+}
+type isVariableDefinition_Default interface {
+	isVariableDefinition_Default()
+}
+
+type VariableDefinition_Ident struct {
+	Ident *Ident `protobuf:"bytes,2,opt,name=ident,proto3,oneof" json:"ident,omitempty"`
+}
+type VariableDefinition_List struct {
+	List *List `protobuf:"bytes,3,opt,name=list,proto3,oneof" json:"list,omitempty"`
+}
+type VariableDefinition_NonNull struct {
+	NonNull *NonNull `protobuf:"bytes,4,opt,name=nonNull,proto3,oneof" json:"nonNull,omitempty"`
+}
+type VariableDefinition_BasicLit struct {
+	BasicLit *BasicLit `protobuf:"bytes,5,opt,name=basicLit,proto3,oneof" json:"basicLit,omitempty"`
+}
+type VariableDefinition_CompositeLit struct {
+	CompositeLit *CompositeLit `protobuf:"bytes,6,opt,name=compositeLit,proto3,oneof" json:"compositeLit,omitempty"`
+}
+
+func (*VariableDefinition_Ident) isVariableDefinition_Type()           {}
+func (*VariableDefinition_List) isVariableDefinition_Type()            {}
+func (*VariableDefinition_NonNull) isVariableDefinition_Type()         {}
+func (*VariableDefinition_BasicLit) isVariableDefinition_Default()     {}
+func (*VariableDefinition_CompositeLit) isVariableDefinition_Default() {}
+
+func (m *VariableDefinition) GetType() isVariableDefinition_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+func (m *VariableDefinition) GetDefault() isVariableDefinition_Default {
+	if m != nil {
+		return m.Default
+	}
+	return nil
+}
+
+func (m *VariableDefinition) GetVariable() *Variable {
+	if m != nil {
+		return m.Variable
+	}
+	return nil
+}
+
+func (m *VariableDefinition) GetIdent() *Ident {
+	if x, ok := m.GetType().(*VariableDefinition_Ident); ok {
+		return x.Ident
+	}
+	return nil
+}
+
+func (m *VariableDefinition) GetList() *List {
+	if x, ok := m.GetType().(*VariableDefinition_List); ok {
+		return x.List
+	}
+	return nil
+}
+
+func (m *VariableDefinition) GetNonNull() *NonNull {
+	if x, ok := m.GetType().(*VariableDefinition_NonNull); ok {
+		return x.NonNull
+	}
+	return nil
+}
+
+func (m *VariableDefinition) GetBasicLit() *BasicLit {
+	if x, ok := m.GetDefault().(*VariableDefinition_BasicLit); ok {
+		return x.BasicLit
+	}
+	return nil
+}
+
+func (m *VariableDefinition) GetCompositeLit() *CompositeLit {
+	if x, ok := m.GetDefault().(*VariableDefinition_CompositeLit); ok {
+		return x.CompositeLit
+	}
+	return nil
+}
+
+func (m *VariableDefinition) GetDirectives() []*DirectiveLit {
+	if m != nil {
+		return m.Directives
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*VariableDefinition) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*VariableDefinition_Ident)(nil),
+		(*VariableDefinition_List)(nil),
+		(*VariableDefinition_NonNull)(nil),
+		(*VariableDefinition_BasicLit)(nil),
+		(*VariableDefinition_CompositeLit)(nil),
+	}
+}
+
+// VariableDefinitionList represents a list of VariableDefinitions, enclosed by parentheses.
+type VariableDefinitionList struct {
+	Opening              int64                 `protobuf:"varint,1,opt,name=opening,proto3" json:"opening,omitempty"`
+	List                 []*VariableDefinition `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	Closing              int64                 `protobuf:"varint,3,opt,name=closing,proto3" json:"closing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VariableDefinitionList) Reset()         { *m = VariableDefinitionList{} }
+func (m *VariableDefinitionList) String() string { return proto.CompactTextString(m) }
+func (*VariableDefinitionList) ProtoMessage()    {}
+func (*VariableDefinitionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{33}
+}
+func (m *VariableDefinitionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VariableDefinitionList.Unmarshal(m, b)
+}
+func (m *VariableDefinitionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VariableDefinitionList.Marshal(b, m, deterministic)
+}
+func (m *VariableDefinitionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VariableDefinitionList.Merge(m, src)
+}
+func (m *VariableDefinitionList) XXX_Size() int {
+	return xxx_messageInfo_VariableDefinitionList.Size(m)
+}
+func (m *VariableDefinitionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_VariableDefinitionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VariableDefinitionList proto.InternalMessageInfo
+
+func (m *VariableDefinitionList) GetOpening() int64 {
+	if m != nil {
+		return m.Opening
+	}
+	return 0
+}
+
+func (m *VariableDefinitionList) GetList() []*VariableDefinition {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+func (m *VariableDefinitionList) GetClosing() int64 {
+	if m != nil {
+		return m.Closing
+	}
+	return 0
+}
+
+// Variable represents a variable reference e.g. $name
+type Variable struct {
+	Dollar               int64    `protobuf:"varint,1,opt,name=dollar,proto3" json:"dollar,omitempty"`
+	Name                 *Ident   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Variable) Reset()         { *m = Variable{} }
+func (m *Variable) String() string { return proto.CompactTextString(m) }
+func (*Variable) ProtoMessage()    {}
+func (*Variable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{34}
+}
+func (m *Variable) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Variable.Unmarshal(m, b)
+}
+func (m *Variable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Variable.Marshal(b, m, deterministic)
+}
+func (m *Variable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Variable.Merge(m, src)
+}
+func (m *Variable) XXX_Size() int {
+	return xxx_messageInfo_Variable.Size(m)
+}
+func (m *Variable) XXX_DiscardUnknown() {
+	xxx_messageInfo_Variable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Variable proto.InternalMessageInfo
+
+func (m *Variable) GetDollar() int64 {
+	if m != nil {
+		return m.Dollar
+	}
+	return 0
+}
+
+func (m *Variable) GetName() *Ident {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+// SelectionSet represents a list of Selections, enclosed by braces.
+type SelectionSet struct {
+	Opening              int64        `protobuf:"varint,1,opt,name=opening,proto3" json:"opening,omitempty"`
+	List                 []*Selection `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	Closing              int64        `protobuf:"varint,3,opt,name=closing,proto3" json:"closing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SelectionSet) Reset()         { *m = SelectionSet{} }
+func (m *SelectionSet) String() string { return proto.CompactTextString(m) }
+func (*SelectionSet) ProtoMessage()    {}
+func (*SelectionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{35}
+}
+func (m *SelectionSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectionSet.Unmarshal(m, b)
+}
+func (m *SelectionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectionSet.Marshal(b, m, deterministic)
+}
+func (m *SelectionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectionSet.Merge(m, src)
+}
+func (m *SelectionSet) XXX_Size() int {
+	return xxx_messageInfo_SelectionSet.Size(m)
+}
+func (m *SelectionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectionSet proto.InternalMessageInfo
+
+func (m *SelectionSet) GetOpening() int64 {
+	if m != nil {
+		return m.Opening
+	}
+	return 0
+}
+
+func (m *SelectionSet) GetList() []*Selection {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+func (m *SelectionSet) GetClosing() int64 {
+	if m != nil {
+		return m.Closing
+	}
+	return 0
+}
+
+// Selection represents a single entry in a selection set.
+type Selection struct {
+	// Types that are valid to be assigned to Selection:
+	//	*Selection_Field
+	//	*Selection_FragmentSpread
+	//	*Selection_InlineFragment
+	Selection            isSelection_Selection `protobuf_oneof:"selection"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Selection) Reset()         { *m = Selection{} }
+func (m *Selection) String() string { return proto.CompactTextString(m) }
+func (*Selection) ProtoMessage()    {}
+func (*Selection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{36}
+}
+func (m *Selection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Selection.Unmarshal(m, b)
+}
+func (m *Selection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Selection.Marshal(b, m, deterministic)
+}
+func (m *Selection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Selection.Merge(m, src)
+}
+func (m *Selection) XXX_Size() int {
+	return xxx_messageInfo_Selection.Size(m)
+}
+func (m *Selection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Selection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Selection proto.InternalMessageInfo
+
+type isSelection_Selection interface {
+	isSelection_Selection()
+}
+
+type Selection_Field struct {
+	Field *FieldSelection `protobuf:"bytes,1,opt,name=field,proto3,oneof" json:"field,omitempty"`
+}
+type Selection_FragmentSpread struct {
+	FragmentSpread *FragmentSpread `protobuf:"bytes,2,opt,name=fragmentSpread,proto3,oneof" json:"fragmentSpread,omitempty"`
+}
+type Selection_InlineFragment struct {
+	InlineFragment *InlineFragment `protobuf:"bytes,3,opt,name=inlineFragment,proto3,oneof" json:"inlineFragment,omitempty"`
+}
+
+func (*Selection_Field) isSelection_Selection()          {}
+func (*Selection_FragmentSpread) isSelection_Selection() {}
+func (*Selection_InlineFragment) isSelection_Selection() {}
+
+func (m *Selection) GetSelection() isSelection_Selection {
+	if m != nil {
+		return m.Selection
+	}
+	return nil
+}
+
+func (m *Selection) GetField() *FieldSelection {
+	if x, ok := m.GetSelection().(*Selection_Field); ok {
+		return x.Field
+	}
+	return nil
+}
+
+func (m *Selection) GetFragmentSpread() *FragmentSpread {
+	if x, ok := m.GetSelection().(*Selection_FragmentSpread); ok {
+		return x.FragmentSpread
+	}
+	return nil
+}
+
+func (m *Selection) GetInlineFragment() *InlineFragment {
+	if x, ok := m.GetSelection().(*Selection_InlineFragment); ok {
+		return x.InlineFragment
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Selection) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Selection_Field)(nil),
+		(*Selection_FragmentSpread)(nil),
+		(*Selection_InlineFragment)(nil),
+	}
+}
+
+// FieldSelection represents a selected field, along with its alias and arguments.
+type FieldSelection struct {
+	Alias                *Ident          `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Name                 *Ident          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Args                 *CallExpr       `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	Directives           []*DirectiveLit `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
+	SelectionSet         *SelectionSet   `protobuf:"bytes,5,opt,name=selectionSet,proto3" json:"selectionSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FieldSelection) Reset()         { *m = FieldSelection{} }
+func (m *FieldSelection) String() string { return proto.CompactTextString(m) }
+func (*FieldSelection) ProtoMessage()    {}
+func (*FieldSelection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{37}
+}
+func (m *FieldSelection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldSelection.Unmarshal(m, b)
+}
+func (m *FieldSelection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldSelection.Marshal(b, m, deterministic)
+}
+func (m *FieldSelection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldSelection.Merge(m, src)
+}
+func (m *FieldSelection) XXX_Size() int {
+	return xxx_messageInfo_FieldSelection.Size(m)
+}
+func (m *FieldSelection) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldSelection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldSelection proto.InternalMessageInfo
+
+func (m *FieldSelection) GetAlias() *Ident {
+	if m != nil {
+		return m.Alias
+	}
+	return nil
+}
+
+func (m *FieldSelection) GetName() *Ident {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *FieldSelection) GetArgs() *CallExpr {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *FieldSelection) GetDirectives() []*DirectiveLit {
+	if m != nil {
+		return m.Directives
+	}
+	return nil
+}
+
+func (m *FieldSelection) GetSelectionSet() *SelectionSet {
+	if m != nil {
+		return m.SelectionSet
+	}
+	return nil
+}
+
+// FragmentSpread represents a named fragment spread e.g. ...Name
+type FragmentSpread struct {
+	Spread               int64           `protobuf:"varint,1,opt,name=spread,proto3" json:"spread,omitempty"`
+	Name                 *Ident          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Directives           []*DirectiveLit `protobuf:"bytes,3,rep,name=directives,proto3" json:"directives,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FragmentSpread) Reset()         { *m = FragmentSpread{} }
+func (m *FragmentSpread) String() string { return proto.CompactTextString(m) }
+func (*FragmentSpread) ProtoMessage()    {}
+func (*FragmentSpread) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{38}
+}
+func (m *FragmentSpread) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FragmentSpread.Unmarshal(m, b)
+}
+func (m *FragmentSpread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FragmentSpread.Marshal(b, m, deterministic)
+}
+func (m *FragmentSpread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FragmentSpread.Merge(m, src)
+}
+func (m *FragmentSpread) XXX_Size() int {
+	return xxx_messageInfo_FragmentSpread.Size(m)
+}
+func (m *FragmentSpread) XXX_DiscardUnknown() {
+	xxx_messageInfo_FragmentSpread.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FragmentSpread proto.InternalMessageInfo
+
+func (m *FragmentSpread) GetSpread() int64 {
+	if m != nil {
+		return m.Spread
+	}
+	return 0
+}
+
+func (m *FragmentSpread) GetName() *Ident {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *FragmentSpread) GetDirectives() []*DirectiveLit {
+	if m != nil {
+		return m.Directives
+	}
+	return nil
+}
+
+// InlineFragment represents an inline fragment e.g. ... on Type { }
+type InlineFragment struct {
+	Spread               int64           `protobuf:"varint,1,opt,name=spread,proto3" json:"spread,omitempty"`
+	OnPos                int64           `protobuf:"varint,2,opt,name=onPos,proto3" json:"onPos,omitempty"`
+	TypeCond             *Ident          `protobuf:"bytes,3,opt,name=typeCond,proto3" json:"typeCond,omitempty"`
+	Directives           []*DirectiveLit `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
+	SelectionSet         *SelectionSet   `protobuf:"bytes,5,opt,name=selectionSet,proto3" json:"selectionSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *InlineFragment) Reset()         { *m = InlineFragment{} }
+func (m *InlineFragment) String() string { return proto.CompactTextString(m) }
+func (*InlineFragment) ProtoMessage()    {}
+func (*InlineFragment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37b5b141da493253, []int{39}
+}
+func (m *InlineFragment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InlineFragment.Unmarshal(m, b)
+}
+func (m *InlineFragment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InlineFragment.Marshal(b, m, deterministic)
+}
+func (m *InlineFragment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InlineFragment.Merge(m, src)
+}
+func (m *InlineFragment) XXX_Size() int {
+	return xxx_messageInfo_InlineFragment.Size(m)
+}
+func (m *InlineFragment) XXX_DiscardUnknown() {
+	xxx_messageInfo_InlineFragment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InlineFragment proto.InternalMessageInfo
+
+func (m *InlineFragment) GetSpread() int64 {
+	if m != nil {
+		return m.Spread
+	}
+	return 0
+}
+
+func (m *InlineFragment) GetOnPos() int64 {
+	if m != nil {
+		return m.OnPos
+	}
+	return 0
+}
+
+func (m *InlineFragment) GetTypeCond() *Ident {
+	if m != nil {
+		return m.TypeCond
+	}
+	return nil
+}
+
+func (m *InlineFragment) GetDirectives() []*DirectiveLit {
+	if m != nil {
+		return m.Directives
+	}
+	return nil
+}
+
+func (m *InlineFragment) GetSelectionSet() *SelectionSet {
+	if m != nil {
+		return m.SelectionSet
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("gqlc.protobuf.DirectiveLocation_Loc", DirectiveLocation_Loc_name, DirectiveLocation_Loc_value)
+	proto.RegisterEnum("gqlc.protobuf.OperationDefinition_Operation", OperationDefinition_Operation_name, OperationDefinition_Operation_value)
 	proto.RegisterType((*Document)(nil), "gqlc.protobuf.Document")
 	proto.RegisterType((*DocGroup)(nil), "gqlc.protobuf.DocGroup")
 	proto.RegisterType((*DocGroup_Doc)(nil), "gqlc.protobuf.DocGroup.Doc")
@@ -2296,114 +3277,159 @@ func init() {
 	proto.RegisterType((*TypeSpec)(nil), "gqlc.protobuf.TypeSpec")
 	proto.RegisterType((*TypeExtensionSpec)(nil), "gqlc.protobuf.TypeExtensionSpec")
 	proto.RegisterType((*TypeDecl)(nil), "gqlc.protobuf.TypeDecl")
+	proto.RegisterType((*ExecutableDocument)(nil), "gqlc.protobuf.ExecutableDocument")
+	proto.RegisterType((*ExecutableDefinition)(nil), "gqlc.protobuf.ExecutableDefinition")
+	proto.RegisterType((*OperationDefinition)(nil), "gqlc.protobuf.OperationDefinition")
+	proto.RegisterType((*FragmentDefinition)(nil), "gqlc.protobuf.FragmentDefinition")
+	proto.RegisterType((*VariableDefinition)(nil), "gqlc.protobuf.VariableDefinition")
+	proto.RegisterType((*VariableDefinitionList)(nil), "gqlc.protobuf.VariableDefinitionList")
+	proto.RegisterType((*Variable)(nil), "gqlc.protobuf.Variable")
+	proto.RegisterType((*SelectionSet)(nil), "gqlc.protobuf.SelectionSet")
+	proto.RegisterType((*Selection)(nil), "gqlc.protobuf.Selection")
+	proto.RegisterType((*FieldSelection)(nil), "gqlc.protobuf.FieldSelection")
+	proto.RegisterType((*FragmentSpread)(nil), "gqlc.protobuf.FragmentSpread")
+	proto.RegisterType((*InlineFragment)(nil), "gqlc.protobuf.InlineFragment")
 }
 
 func init() { proto.RegisterFile("ast.proto", fileDescriptor_37b5b141da493253) }
 
 var fileDescriptor_37b5b141da493253 = []byte{
-	// 1660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0xdb, 0x56,
-	0x16, 0x16, 0x45, 0x51, 0x3f, 0xc7, 0x3f, 0x51, 0xae, 0x3d, 0x8e, 0x46, 0x93, 0x01, 0x0c, 0x62,
-	0x10, 0x38, 0x98, 0xb1, 0x33, 0x23, 0xc7, 0x83, 0x02, 0xcd, 0xa2, 0xb2, 0x45, 0x5b, 0x2a, 0x64,
-	0xd9, 0xb9, 0x96, 0x82, 0xb4, 0x40, 0x61, 0xd0, 0x34, 0xed, 0x30, 0xa6, 0x48, 0x95, 0xa4, 0x82,
-	0x64, 0xd7, 0x45, 0x5f, 0xa1, 0xab, 0xee, 0xdb, 0x4d, 0x57, 0x5d, 0xf5, 0x15, 0xfa, 0x18, 0x5d,
-	0xb4, 0x7d, 0x87, 0xae, 0x8a, 0x73, 0x7f, 0x48, 0x4a, 0xa6, 0x64, 0x3b, 0x68, 0x56, 0xbe, 0x47,
-	0xfc, 0xce, 0xcf, 0x3d, 0xf7, 0x3b, 0xe7, 0x9e, 0x6b, 0xa8, 0x98, 0x61, 0xb4, 0x35, 0x0a, 0xfc,
-	0xc8, 0x27, 0x4b, 0x97, 0x5f, 0xba, 0x16, 0x5f, 0x9f, 0x8d, 0x2f, 0xea, 0x0b, 0x91, 0x7f, 0x65,
-	0x7b, 0x5c, 0xd6, 0x7f, 0x53, 0xa0, 0xdc, 0xf2, 0xad, 0xf1, 0xd0, 0xf6, 0x22, 0x42, 0xa0, 0xe0,
-	0x99, 0x43, 0xbb, 0xa6, 0xac, 0x2b, 0x1b, 0x15, 0xca, 0xd6, 0xe4, 0x31, 0xa8, 0xe7, 0xbe, 0x55,
-	0xcb, 0xaf, 0x2b, 0x1b, 0x0b, 0x8d, 0x07, 0x5b, 0x13, 0xa6, 0xb6, 0x5a, 0xbe, 0x75, 0x10, 0xf8,
-	0xe3, 0x11, 0x45, 0x0c, 0xf9, 0x18, 0xe0, 0xdc, 0x09, 0x6c, 0x2b, 0x72, 0xde, 0xd8, 0x61, 0x4d,
-	0x5d, 0x57, 0x37, 0x16, 0x1a, 0xff, 0x98, 0xd6, 0x90, 0x80, 0xae, 0x13, 0xd1, 0x14, 0x9c, 0x3c,
-	0x81, 0x62, 0x68, 0xbd, 0xb2, 0x87, 0x66, 0xad, 0x90, 0xe9, 0xaa, 0xff, 0x6e, 0x64, 0xb7, 0x6c,
-	0xcb, 0xa5, 0x02, 0x46, 0x36, 0x41, 0x8b, 0xde, 0x8d, 0xec, 0xb0, 0xa6, 0x31, 0x47, 0x33, 0xf1,
-	0x1c, 0xa5, 0x7f, 0xcd, 0x37, 0xca, 0xc2, 0x25, 0x4f, 0xa0, 0xe0, 0x3a, 0x61, 0x54, 0x53, 0xb2,
-	0x63, 0x14, 0x30, 0x5c, 0x50, 0x06, 0xac, 0x1f, 0x80, 0xda, 0xf2, 0x2d, 0x4c, 0x50, 0x64, 0xbf,
-	0x8d, 0x64, 0x82, 0x70, 0x8d, 0xbf, 0x59, 0xaf, 0xcc, 0x80, 0x65, 0x48, 0xa5, 0x6c, 0x4d, 0x6a,
-	0x50, 0xb2, 0xfc, 0x21, 0xe6, 0xb4, 0xa6, 0xae, 0x2b, 0x1b, 0x65, 0x2a, 0x45, 0xfd, 0x47, 0x05,
-	0xd4, 0x66, 0x70, 0x49, 0x36, 0x52, 0xa9, 0x5e, 0x68, 0xac, 0x4e, 0x45, 0xd0, 0x39, 0xb7, 0xbd,
-	0x48, 0x1c, 0xc0, 0x0e, 0x94, 0xcf, 0xcc, 0xd0, 0xb1, 0xba, 0x4e, 0x34, 0xe3, 0x14, 0x76, 0xc5,
-	0xe7, 0x76, 0x8e, 0xc6, 0x50, 0xd2, 0x84, 0x45, 0xcb, 0x1f, 0x8e, 0xfc, 0xd0, 0x89, 0x30, 0xd7,
-	0x2c, 0x8e, 0xeb, 0x5b, 0xdd, 0x4b, 0x41, 0xda, 0x39, 0x3a, 0xa1, 0xb2, 0x5b, 0x02, 0xed, 0x8d,
-	0xe9, 0x8e, 0x6d, 0xfd, 0x97, 0x3c, 0x68, 0xfb, 0x8e, 0xed, 0x9e, 0x4b, 0x36, 0x28, 0xb7, 0x60,
-	0x83, 0xdc, 0x61, 0xfe, 0xc6, 0x1d, 0xfe, 0x0f, 0x0a, 0x66, 0x70, 0x19, 0x8a, 0x10, 0xff, 0x39,
-	0x8d, 0xf4, 0x46, 0xe3, 0xe8, 0x05, 0xc6, 0xd1, 0x75, 0xc2, 0x88, 0x32, 0x28, 0xf9, 0x0f, 0x68,
-	0x0e, 0x5a, 0x10, 0x64, 0xc9, 0xb4, 0xde, 0xce, 0x51, 0x0e, 0x22, 0x8f, 0xc5, 0x71, 0x6b, 0x0c,
-	0xbc, 0x32, 0x05, 0x46, 0xb3, 0xed, 0x1c, 0x3f, 0x68, 0xd2, 0x80, 0x92, 0xe7, 0x7b, 0xbd, 0xb1,
-	0xeb, 0xd6, 0x8a, 0x0c, 0xbd, 0x36, 0x85, 0xee, 0xf1, 0xaf, 0xed, 0x1c, 0x95, 0xc0, 0x29, 0xde,
-	0x57, 0xee, 0xc4, 0xfb, 0xdd, 0x22, 0x14, 0x90, 0xa0, 0xba, 0x03, 0x15, 0x96, 0x62, 0x8c, 0x06,
-	0xf9, 0xe3, 0x8f, 0x6c, 0xcf, 0xf1, 0x2e, 0x59, 0xaa, 0x55, 0x2a, 0x45, 0xcc, 0x2a, 0xdb, 0x4a,
-	0x9e, 0x79, 0x99, 0xde, 0x37, 0xb3, 0x20, 0x76, 0x82, 0x1c, 0x74, 0xfd, 0x10, 0x6d, 0xa8, 0xdc,
-	0x86, 0x10, 0xf5, 0x9f, 0x54, 0x80, 0x24, 0xab, 0x1f, 0xe6, 0x4c, 0xe3, 0x03, 0x52, 0xef, 0x72,
-	0x40, 0x85, 0x3b, 0x1d, 0x90, 0x76, 0xdb, 0x03, 0x4a, 0x97, 0x50, 0x71, 0x7e, 0x09, 0x29, 0x73,
-	0x4a, 0xa8, 0x74, 0x73, 0x09, 0x29, 0x93, 0x25, 0xf4, 0x97, 0x50, 0x63, 0xb7, 0x02, 0xa5, 0x73,
-	0xfb, 0xc2, 0x1c, 0xbb, 0x91, 0x1e, 0xc2, 0xf2, 0x64, 0x3d, 0xcc, 0xa1, 0xca, 0xe6, 0x04, 0x55,
-	0xfe, 0x3e, 0xb3, 0xac, 0x6e, 0xe4, 0xcb, 0x0e, 0x68, 0xec, 0xbc, 0x10, 0x82, 0x87, 0x7b, 0xec,
-	0x87, 0xd2, 0x97, 0x10, 0xe3, 0x9b, 0x23, 0x9f, 0xdc, 0x1c, 0xfa, 0x05, 0x94, 0x65, 0x5a, 0x49,
-	0x1d, 0xca, 0xac, 0x95, 0x24, 0xaa, 0xb1, 0x8c, 0xa4, 0xba, 0x72, 0xbc, 0x73, 0xa6, 0xbb, 0x7c,
-	0x8d, 0x29, 0x7d, 0xbc, 0xac, 0x28, 0x43, 0x90, 0x55, 0xd1, 0x90, 0x58, 0x80, 0x15, 0x2a, 0xba,
-	0xd3, 0xef, 0x0a, 0x2c, 0xa6, 0x0f, 0x61, 0x4e, 0x4a, 0xde, 0xb3, 0x97, 0x36, 0xa0, 0x84, 0x29,
-	0x4a, 0xda, 0xe8, 0x5a, 0x06, 0x43, 0xb9, 0x92, 0x04, 0xe2, 0x7d, 0xe6, 0x9f, 0xbd, 0x46, 0x15,
-	0x4e, 0xea, 0xbf, 0x4d, 0xa9, 0x1c, 0xb1, 0x8f, 0xed, 0x1c, 0x15, 0xb0, 0x74, 0xfe, 0xb5, 0x89,
-	0xfc, 0x27, 0x7d, 0xf8, 0x9b, 0x3c, 0x94, 0x84, 0x2b, 0xf2, 0x0c, 0x2a, 0x22, 0xbe, 0x30, 0x12,
-	0xb5, 0xfb, 0x30, 0x3b, 0x2a, 0xbe, 0xa7, 0x76, 0x8e, 0x26, 0x0a, 0xa4, 0x0d, 0x4b, 0x29, 0x9e,
-	0x86, 0x32, 0x1b, 0xeb, 0x33, 0x2c, 0xc4, 0xe9, 0x6d, 0xe7, 0xe8, 0xa4, 0x62, 0xfd, 0x23, 0xd0,
-	0x98, 0x7d, 0xdc, 0x30, 0x8b, 0x32, 0x14, 0xb7, 0xea, 0xac, 0xcc, 0x52, 0x01, 0xab, 0x7f, 0x02,
-	0x95, 0xd8, 0x2e, 0xd9, 0x9e, 0xd2, 0x9e, 0x57, 0x65, 0xd2, 0x02, 0x16, 0x08, 0xa6, 0x5b, 0xff,
-	0x56, 0x81, 0x22, 0xcf, 0x27, 0x69, 0x40, 0xf1, 0x02, 0x9b, 0xa0, 0xb4, 0x53, 0xcf, 0x4c, 0xfb,
-	0xd6, 0xb1, 0xe9, 0x04, 0x54, 0x20, 0xeb, 0x5f, 0x40, 0x01, 0x65, 0xf2, 0x08, 0xd4, 0x2b, 0xfb,
-	0xdd, 0xdc, 0x2b, 0x19, 0x01, 0x64, 0x13, 0xd4, 0x37, 0xa6, 0x2b, 0x52, 0x36, 0x37, 0x50, 0xc4,
-	0x61, 0x74, 0x05, 0x96, 0xf4, 0xb8, 0x27, 0x2a, 0x77, 0xe9, 0x89, 0xf9, 0x3b, 0xf5, 0x44, 0xf5,
-	0x96, 0x3d, 0x31, 0xbe, 0x77, 0x5e, 0x43, 0x49, 0x7c, 0xfd, 0x60, 0xf1, 0xc5, 0xbe, 0x6c, 0x58,
-	0x4c, 0x37, 0x3b, 0xac, 0x67, 0x33, 0x4a, 0x5a, 0x02, 0x17, 0xb2, 0x7a, 0x09, 0xf9, 0xf7, 0xc4,
-	0x88, 0x30, 0x4d, 0xad, 0x3d, 0xd3, 0x75, 0x8d, 0xb7, 0xa3, 0x80, 0x0f, 0x07, 0xfa, 0xcf, 0x2a,
-	0xdc, 0x4f, 0xfc, 0xf8, 0x96, 0x19, 0x39, 0xbe, 0x87, 0xce, 0xc2, 0xc8, 0x0c, 0x22, 0xe9, 0x8c,
-	0x09, 0xe4, 0xff, 0xa0, 0xba, 0x62, 0xbc, 0x5d, 0x6e, 0xfc, 0x6b, 0x66, 0x67, 0x16, 0x46, 0xb6,
-	0xba, 0xbe, 0x45, 0x51, 0x41, 0xff, 0x35, 0x0f, 0x6a, 0xd7, 0xb7, 0x48, 0x05, 0xb4, 0x9e, 0x7f,
-	0xec, 0x87, 0xd5, 0x1c, 0x2e, 0x9f, 0x0f, 0x0c, 0xfa, 0x59, 0x55, 0x21, 0x8b, 0x50, 0x3e, 0x1c,
-	0xf4, 0x9b, 0xfd, 0xce, 0x51, 0xaf, 0x9a, 0x27, 0x55, 0x58, 0x3c, 0x19, 0xec, 0x9e, 0xec, 0xd1,
-	0xce, 0x31, 0xfb, 0x45, 0x45, 0xe8, 0x7e, 0xc7, 0xe8, 0xb6, 0xaa, 0x05, 0xf2, 0x00, 0x56, 0xf6,
-	0x69, 0xf3, 0xe0, 0xd0, 0xe8, 0xf5, 0x4f, 0x5b, 0xc6, 0x7e, 0xa7, 0xd7, 0x61, 0x18, 0x8d, 0xac,
-	0xc0, 0xbd, 0xf8, 0xc3, 0xc9, 0x31, 0x35, 0x9a, 0xad, 0x6a, 0x11, 0x7f, 0xec, 0xf4, 0xba, 0x9d,
-	0x9e, 0x71, 0x2a, 0xbf, 0x55, 0x4b, 0x68, 0xe2, 0x45, 0x93, 0x76, 0x9a, 0xbb, 0x5d, 0x23, 0x6d,
-	0xa2, 0x8c, 0x61, 0xb4, 0x8e, 0xf6, 0x06, 0x0c, 0x56, 0x21, 0x00, 0xc5, 0x93, 0xbd, 0xb6, 0x71,
-	0xd8, 0xac, 0x02, 0x5f, 0x37, 0xbb, 0x4d, 0x5a, 0x5d, 0xc0, 0xf5, 0xd1, 0xee, 0xa7, 0xc6, 0x5e,
-	0xbf, 0xba, 0x48, 0x56, 0xa1, 0xca, 0x02, 0x4b, 0xdb, 0x59, 0x42, 0x07, 0x4d, 0x7a, 0x30, 0x98,
-	0x8e, 0x71, 0x99, 0x2c, 0x41, 0xa5, 0xd3, 0xeb, 0x1b, 0x74, 0xbf, 0xb9, 0x67, 0x54, 0xef, 0xe1,
-	0xb6, 0x06, 0x3d, 0xfc, 0x52, 0x25, 0x65, 0x28, 0x18, 0xbd, 0xc1, 0x61, 0xf5, 0x3e, 0x59, 0x06,
-	0xc0, 0xd5, 0xe9, 0x8b, 0x66, 0x77, 0x60, 0x54, 0x09, 0x66, 0xa3, 0xd3, 0x3b, 0x1e, 0xf4, 0x4f,
-	0x85, 0xd3, 0x15, 0x52, 0x87, 0x35, 0xfe, 0xcb, 0x35, 0xd7, 0xab, 0xfa, 0x19, 0x94, 0xe5, 0xe9,
-	0x92, 0x35, 0x28, 0xba, 0x23, 0x33, 0xb0, 0x3d, 0x71, 0x84, 0x42, 0x22, 0x8f, 0x04, 0x39, 0xf8,
-	0x45, 0x47, 0xa6, 0x0e, 0xb1, 0x19, 0x5c, 0x8a, 0xa1, 0x71, 0x0d, 0x8a, 0x01, 0xd7, 0xe7, 0x17,
-	0x9c, 0x90, 0xf4, 0x97, 0x00, 0x27, 0xec, 0x4d, 0x81, 0x6f, 0x06, 0x44, 0x89, 0x87, 0x88, 0xf0,
-	0x22, 0xde, 0x1b, 0x0d, 0x28, 0x05, 0xbe, 0x1f, 0x1d, 0x8d, 0x42, 0x41, 0xf9, 0x5a, 0xd6, 0xf0,
-	0xc5, 0x66, 0x54, 0x09, 0xd4, 0x7b, 0x68, 0xd9, 0x74, 0xcd, 0x20, 0xb1, 0x8c, 0x52, 0x62, 0x19,
-	0xa5, 0xdb, 0x4f, 0x55, 0xfa, 0x77, 0x0a, 0xc0, 0xd1, 0xd9, 0x6b, 0xdb, 0x8a, 0xa4, 0x41, 0x9f,
-	0x49, 0xd2, 0x20, 0x97, 0xf0, 0x2a, 0x71, 0x86, 0x23, 0x17, 0x2b, 0x8b, 0xbf, 0x4a, 0xa4, 0x48,
-	0x9e, 0x02, 0x38, 0x5e, 0x64, 0x07, 0x17, 0xa6, 0x15, 0x3f, 0xd1, 0xb2, 0x1d, 0xa6, 0x70, 0xe4,
-	0xbf, 0x71, 0x53, 0x2d, 0xdc, 0xb0, 0x73, 0x81, 0xd3, 0x4f, 0x61, 0xa9, 0x23, 0xf5, 0x59, 0xa8,
-	0x0f, 0xa1, 0x12, 0x1b, 0x14, 0xd1, 0x26, 0x3f, 0xa4, 0x1c, 0xe4, 0x6f, 0xe9, 0xe0, 0x39, 0x54,
-	0x06, 0x9e, 0xe3, 0x7b, 0xcc, 0xf8, 0x2a, 0x68, 0x63, 0x14, 0x64, 0x69, 0x33, 0x81, 0x6c, 0x41,
-	0x69, 0x68, 0x0f, 0xcf, 0xec, 0x20, 0x9c, 0x31, 0x2d, 0xf3, 0x8d, 0x4a, 0x90, 0x7e, 0x0c, 0x65,
-	0xc3, 0x1b, 0x0f, 0x99, 0x45, 0x02, 0x05, 0xdb, 0x1b, 0x0f, 0x85, 0x41, 0xb6, 0xc6, 0x20, 0xc5,
-	0x15, 0x75, 0x63, 0x90, 0x1c, 0xa7, 0xbf, 0x84, 0x0a, 0x1b, 0xb3, 0x64, 0x90, 0x0e, 0x0a, 0x32,
-	0x48, 0x26, 0x90, 0x9d, 0xa9, 0x9d, 0xdf, 0xf0, 0xfa, 0x91, 0xdb, 0xff, 0x41, 0x81, 0xa5, 0xb8,
-	0x3b, 0xc9, 0x04, 0xc7, 0xa3, 0xa3, 0x4c, 0x70, 0xfc, 0x43, 0xfc, 0xc4, 0xca, 0xdf, 0xfe, 0x89,
-	0xb5, 0x0a, 0x9a, 0xef, 0x21, 0x85, 0x78, 0xb1, 0x70, 0x81, 0x3c, 0x85, 0x82, 0xeb, 0x5b, 0x48,
-	0x04, 0x35, 0x63, 0x5e, 0xb8, 0xd6, 0x30, 0x29, 0x43, 0xeb, 0xdf, 0x17, 0xa0, 0x8c, 0x51, 0x9e,
-	0x8c, 0x6c, 0xeb, 0x0e, 0x4f, 0xdf, 0xed, 0xb8, 0x14, 0x79, 0xdc, 0xd3, 0x33, 0x6c, 0x52, 0xb5,
-	0x38, 0x47, 0x89, 0x3a, 0xdd, 0x8e, 0xab, 0x4c, 0x9d, 0xa1, 0x24, 0x0b, 0x92, 0x2b, 0xb1, 0x12,
-	0xdc, 0x8e, 0x2b, 0xa9, 0x90, 0xa9, 0x94, 0x14, 0x9d, 0x98, 0xd8, 0xb0, 0xcc, 0x9e, 0xa5, 0x39,
-	0xad, 0x65, 0x8e, 0x60, 0x13, 0x45, 0x80, 0x23, 0x58, 0x9a, 0xf3, 0x82, 0xb4, 0xc5, 0x4c, 0x36,
-	0xc5, 0xec, 0xc6, 0x1b, 0x97, 0x13, 0x7a, 0x53, 0x90, 0xb2, 0x94, 0x79, 0x09, 0x4a, 0xee, 0xe2,
-	0xad, 0x2b, 0xf8, 0x2a, 0x08, 0x57, 0xce, 0x74, 0x10, 0x33, 0x93, 0x5d, 0xe9, 0x8c, 0x8c, 0xcf,
-	0xd2, 0x1c, 0xaa, 0x64, 0x6e, 0x68, 0x82, 0x74, 0xb8, 0xa1, 0x84, 0x63, 0x93, 0x6f, 0x1d, 0x78,
-	0xbf, 0x67, 0xf0, 0x57, 0x0a, 0xdc, 0x47, 0xd3, 0xc6, 0xdb, 0xc8, 0xf6, 0x42, 0xc7, 0xf7, 0x18,
-	0x65, 0xd6, 0xa0, 0x18, 0xf9, 0x57, 0xc9, 0xa4, 0x20, 0x24, 0x9c, 0xd8, 0x22, 0xff, 0x6a, 0xee,
-	0xcb, 0x01, 0x01, 0x38, 0x3e, 0xa0, 0xf5, 0x19, 0xe3, 0x83, 0x64, 0x26, 0xe5, 0x21, 0xfc, 0xa1,
-	0x70, 0xb2, 0xb6, 0x6c, 0xcb, 0xbd, 0xcb, 0xe3, 0x38, 0x09, 0x32, 0x9f, 0x15, 0xa4, 0x7a, 0x53,
-	0x90, 0x3b, 0x50, 0x8e, 0x44, 0x24, 0x73, 0xfe, 0x07, 0x86, 0x9f, 0xf1, 0x71, 0x22, 0xa1, 0xa4,
-	0x05, 0x0b, 0x11, 0x4f, 0x18, 0xd3, 0xd4, 0x32, 0x07, 0xf9, 0x6b, 0x29, 0x6d, 0xe7, 0x68, 0x5a,
-	0x0d, 0xf3, 0x1f, 0xe2, 0x5f, 0xed, 0x73, 0xd5, 0x0c, 0xa3, 0xb3, 0x22, 0xd3, 0xdc, 0xfe, 0x33,
-	0x00, 0x00, 0xff, 0xff, 0x15, 0x1b, 0x13, 0xf6, 0x46, 0x14, 0x00, 0x00,
+	// 2184 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x23, 0x59,
+	0x11, 0x77, 0x7f, 0xd9, 0xee, 0xca, 0xc7, 0x7a, 0x5e, 0x42, 0xd6, 0x84, 0x05, 0x65, 0x9b, 0x65,
+	0x95, 0x65, 0x67, 0xb2, 0x43, 0x66, 0xb3, 0x42, 0x62, 0xa4, 0xc5, 0x4e, 0x9c, 0xd8, 0xc8, 0xe3,
+	0x64, 0xdb, 0xf1, 0x68, 0x41, 0x42, 0xa3, 0x4e, 0xbb, 0x93, 0xed, 0x49, 0xbb, 0xbb, 0xe9, 0x6e,
+	0x8f, 0x66, 0x6e, 0x48, 0x70, 0x06, 0x71, 0xe0, 0x02, 0x12, 0xda, 0x13, 0x1c, 0xb9, 0xf2, 0x2f,
+	0x70, 0xe7, 0x1f, 0xd8, 0x03, 0x48, 0x5c, 0x38, 0x70, 0xe4, 0x84, 0xea, 0xf5, 0x7b, 0xfd, 0x95,
+	0xb6, 0x1d, 0x67, 0x76, 0xe1, 0xd6, 0xd5, 0xfd, 0xab, 0x7a, 0xf5, 0xea, 0xeb, 0x55, 0xbd, 0x06,
+	0xd5, 0x08, 0xa3, 0x3d, 0x3f, 0xf0, 0x22, 0x8f, 0xac, 0x5d, 0xfd, 0xcc, 0x31, 0xe3, 0xe7, 0x8b,
+	0xe9, 0xe5, 0xf6, 0x4a, 0xe4, 0x5d, 0x5b, 0x6e, 0x4c, 0x6b, 0xff, 0x10, 0xa0, 0x7e, 0xe4, 0x99,
+	0xd3, 0x89, 0xe5, 0x46, 0x84, 0x80, 0xec, 0x1a, 0x13, 0xab, 0x29, 0xec, 0x08, 0xbb, 0xaa, 0x4e,
+	0x9f, 0xc9, 0x7b, 0x20, 0x8d, 0x3d, 0xb3, 0x29, 0xee, 0x08, 0xbb, 0x2b, 0xfb, 0x6f, 0xee, 0xe5,
+	0x44, 0xed, 0x1d, 0x79, 0xe6, 0x49, 0xe0, 0x4d, 0x7d, 0x1d, 0x31, 0xe4, 0x07, 0x00, 0x63, 0x3b,
+	0xb0, 0xcc, 0xc8, 0x7e, 0x61, 0x85, 0x4d, 0x69, 0x47, 0xda, 0x5d, 0xd9, 0xff, 0x46, 0x91, 0x83,
+	0x03, 0xfa, 0x76, 0xa4, 0x67, 0xe0, 0xe4, 0x03, 0xa8, 0x86, 0xe6, 0x67, 0xd6, 0xc4, 0x68, 0xca,
+	0xa5, 0x4b, 0x9d, 0xbf, 0xf2, 0xad, 0x23, 0xcb, 0x74, 0x74, 0x06, 0x23, 0x0f, 0x40, 0x89, 0x5e,
+	0xf9, 0x56, 0xd8, 0x54, 0x76, 0xa4, 0x79, 0xf8, 0x18, 0xa5, 0xfd, 0x32, 0xde, 0x28, 0x55, 0x97,
+	0x7c, 0x00, 0xb2, 0x63, 0x87, 0x51, 0x53, 0x28, 0xd7, 0x91, 0xc1, 0xf0, 0x41, 0xa7, 0xc0, 0xed,
+	0x13, 0x90, 0x8e, 0x3c, 0x13, 0x0d, 0x14, 0x59, 0x2f, 0x23, 0x6e, 0x20, 0x7c, 0xc6, 0x77, 0xe6,
+	0x67, 0x46, 0x40, 0x2d, 0x24, 0xe9, 0xf4, 0x99, 0x34, 0xa1, 0x66, 0x7a, 0x13, 0xb4, 0x69, 0x53,
+	0xda, 0x11, 0x76, 0xeb, 0x3a, 0x27, 0xb5, 0x7f, 0x0a, 0x20, 0xb5, 0x82, 0x2b, 0xb2, 0x9b, 0x31,
+	0xf5, 0xca, 0xfe, 0x66, 0x41, 0x83, 0xde, 0xd8, 0x72, 0x23, 0xe6, 0x80, 0x03, 0xa8, 0x5f, 0x18,
+	0xa1, 0x6d, 0xf6, 0xed, 0x68, 0x86, 0x17, 0xda, 0xec, 0x73, 0xb7, 0xa2, 0x27, 0x50, 0xd2, 0x82,
+	0x55, 0xd3, 0x9b, 0xf8, 0x5e, 0x68, 0x47, 0x68, 0x6b, 0xaa, 0xc7, 0xcd, 0xad, 0x1e, 0x66, 0x20,
+	0xdd, 0x8a, 0x9e, 0x63, 0xc1, 0x95, 0x5f, 0x18, 0x81, 0x6d, 0x5c, 0x38, 0xd6, 0x0c, 0xa7, 0x3c,
+	0x65, 0x9f, 0x71, 0x65, 0x0e, 0x6d, 0xd7, 0x40, 0x79, 0x61, 0x38, 0x53, 0x4b, 0xfb, 0x42, 0x04,
+	0xe5, 0xd8, 0xb6, 0x9c, 0x31, 0x0f, 0x22, 0xe1, 0x16, 0x41, 0xc4, 0x0d, 0x23, 0x2e, 0x34, 0xcc,
+	0xf7, 0x40, 0x36, 0x82, 0xab, 0x90, 0xed, 0xec, 0x9b, 0x45, 0xa4, 0xeb, 0x4f, 0xa3, 0xa7, 0xa8,
+	0x47, 0xdf, 0x0e, 0x23, 0x9d, 0x42, 0xc9, 0x7d, 0x50, 0x6c, 0x94, 0xd0, 0x94, 0x67, 0x4b, 0xef,
+	0x56, 0xf4, 0x18, 0x44, 0xde, 0x63, 0x51, 0xa2, 0x50, 0xf0, 0x46, 0x01, 0x8c, 0x62, 0xbb, 0x95,
+	0x38, 0x3e, 0xc8, 0x3e, 0xd4, 0x5c, 0xcf, 0x1d, 0x4c, 0x1d, 0xa7, 0x59, 0xa5, 0xe8, 0xad, 0x02,
+	0x7a, 0x10, 0x7f, 0xed, 0x56, 0x74, 0x0e, 0x2c, 0xa4, 0x8b, 0xba, 0x54, 0xba, 0xb4, 0xab, 0x20,
+	0x63, 0x5c, 0x6b, 0x36, 0xa8, 0xd4, 0xc4, 0xa8, 0x0d, 0x86, 0x9d, 0xe7, 0x5b, 0xae, 0xed, 0x5e,
+	0x51, 0x53, 0x4b, 0x3a, 0x27, 0xd1, 0xaa, 0x74, 0x2b, 0xe2, 0x8e, 0x54, 0xb2, 0x6f, 0x2a, 0x81,
+	0xed, 0x04, 0x43, 0xd7, 0xf1, 0x42, 0x94, 0x21, 0xc5, 0x32, 0x18, 0xa9, 0xfd, 0x45, 0x02, 0x48,
+	0xad, 0xfa, 0xd5, 0xf8, 0x34, 0x71, 0x90, 0xb4, 0x8c, 0x83, 0xe4, 0xa5, 0x1c, 0xa4, 0xdc, 0xd6,
+	0x41, 0xd9, 0xcc, 0xab, 0xce, 0xcf, 0x3c, 0x61, 0x4e, 0xe6, 0xd5, 0x16, 0x67, 0x9e, 0x50, 0xc8,
+	0xbc, 0x2f, 0x23, 0x34, 0xda, 0x2a, 0xd4, 0xc6, 0xd6, 0xa5, 0x31, 0x75, 0x22, 0x2d, 0x84, 0xf5,
+	0x7c, 0x3e, 0xcc, 0x09, 0x95, 0x07, 0xb9, 0x50, 0xf9, 0xfa, 0xcc, 0xb4, 0x5a, 0x18, 0x2f, 0x07,
+	0xa0, 0x50, 0x7f, 0x21, 0x04, 0x9d, 0x7b, 0xe6, 0x85, 0x7c, 0x2d, 0x46, 0x12, 0x92, 0x09, 0x0c,
+	0x76, 0xe0, 0x68, 0x97, 0x50, 0xe7, 0x66, 0x25, 0xdb, 0x58, 0x81, 0x9c, 0x69, 0x86, 0x35, 0xa1,
+	0x31, 0xa8, 0xae, 0x6d, 0x77, 0x4c, 0x79, 0xd7, 0x6f, 0x44, 0xca, 0x39, 0x9e, 0x71, 0x3a, 0x45,
+	0x90, 0x4d, 0x56, 0x90, 0xa8, 0x82, 0xaa, 0xce, 0xaa, 0xd3, 0x1f, 0x44, 0x58, 0xcd, 0x3a, 0x61,
+	0x8e, 0x49, 0xee, 0x58, 0x82, 0xf7, 0xa1, 0x86, 0x26, 0x4a, 0xab, 0xef, 0x56, 0x49, 0x84, 0xc6,
+	0x4c, 0x1c, 0x88, 0xc7, 0xa0, 0x77, 0xf1, 0xbc, 0x6f, 0xf3, 0xa0, 0xfe, 0x5a, 0x81, 0xe5, 0x94,
+	0x7e, 0xec, 0x56, 0x74, 0x06, 0xcb, 0x15, 0xe9, 0xea, 0xad, 0x8b, 0x74, 0xd6, 0x6d, 0x4a, 0xce,
+	0x6d, 0x69, 0xf9, 0xfe, 0xad, 0x08, 0x35, 0xa6, 0x21, 0x79, 0x0c, 0x2a, 0xdb, 0x56, 0x18, 0xb1,
+	0x94, 0x7f, 0xab, 0x7c, 0x33, 0xb1, 0x29, 0xba, 0x15, 0x3d, 0x65, 0x20, 0x5d, 0x58, 0xcb, 0x84,
+	0x77, 0xc8, 0x8d, 0xb8, 0x33, 0x43, 0x42, 0xe2, 0x95, 0x6e, 0x45, 0xcf, 0x33, 0x6e, 0x7f, 0x1f,
+	0x14, 0x2a, 0x1f, 0xed, 0x44, 0xb5, 0x0c, 0xd9, 0x19, 0x3e, 0xcb, 0x21, 0x3a, 0x83, 0x6d, 0xff,
+	0x10, 0xd4, 0x44, 0x2e, 0x79, 0x54, 0xe0, 0x9e, 0x97, 0x9c, 0x5c, 0x02, 0xe6, 0x15, 0x7a, 0x49,
+	0xfb, 0xbd, 0x00, 0xd5, 0xd8, 0x0d, 0x64, 0x1f, 0xaa, 0x97, 0x58, 0x3b, 0xb9, 0x9c, 0xed, 0x52,
+	0x6f, 0xed, 0x9d, 0x19, 0x76, 0xa0, 0x33, 0xe4, 0xf6, 0x4f, 0x41, 0x46, 0x9a, 0xbc, 0x0b, 0xd2,
+	0xb5, 0xf5, 0x6a, 0x6e, 0x03, 0x80, 0x00, 0xf2, 0x00, 0xa4, 0x17, 0x86, 0xc3, 0x4c, 0x36, 0x57,
+	0x51, 0xc4, 0xa1, 0x76, 0x32, 0x35, 0x7a, 0x52, 0x4a, 0x85, 0x65, 0x4a, 0xa9, 0xb8, 0x54, 0x29,
+	0x95, 0x6e, 0x59, 0x4a, 0x93, 0xe3, 0xea, 0x39, 0xd4, 0xd8, 0xd7, 0xaf, 0x4c, 0xbf, 0x64, 0x2d,
+	0x0b, 0x56, 0xb3, 0x35, 0x12, 0xcb, 0x80, 0x11, 0xa5, 0x95, 0x24, 0x26, 0xca, 0x4a, 0x10, 0x79,
+	0x3f, 0xd7, 0x59, 0x14, 0x43, 0xeb, 0xd0, 0x70, 0x9c, 0xce, 0x4b, 0x3f, 0x88, 0x7b, 0x0a, 0xed,
+	0xaf, 0x12, 0xdc, 0x4b, 0xd7, 0xf1, 0x4c, 0x23, 0xb2, 0x3d, 0x17, 0x17, 0x0b, 0x23, 0x23, 0x88,
+	0xf8, 0x62, 0x94, 0x20, 0x1f, 0x81, 0xe4, 0xb0, 0x66, 0x7a, 0x7d, 0xff, 0x9d, 0x99, 0x05, 0x9d,
+	0x09, 0xd9, 0xeb, 0x7b, 0xa6, 0x8e, 0x0c, 0xda, 0xdf, 0x45, 0x90, 0xfa, 0x9e, 0x49, 0x54, 0x50,
+	0x06, 0xde, 0x99, 0x17, 0x36, 0x2a, 0xf8, 0xf8, 0xc9, 0xa8, 0xa3, 0xff, 0xb8, 0x21, 0x90, 0x55,
+	0xa8, 0x3f, 0x19, 0x9d, 0xb7, 0xce, 0x7b, 0xa7, 0x83, 0x86, 0x48, 0x1a, 0xb0, 0x3a, 0x1c, 0xb5,
+	0x87, 0x87, 0x7a, 0xef, 0x8c, 0xbe, 0x91, 0x10, 0x7a, 0xdc, 0xeb, 0xf4, 0x8f, 0x1a, 0x32, 0x79,
+	0x13, 0x36, 0x8e, 0xf5, 0xd6, 0xc9, 0x93, 0xce, 0xe0, 0xfc, 0xd9, 0x51, 0xe7, 0xb8, 0x37, 0xe8,
+	0x51, 0x8c, 0x42, 0x36, 0xe0, 0x8d, 0xe4, 0xc3, 0xf0, 0x4c, 0xef, 0xb4, 0x8e, 0x1a, 0x55, 0x7c,
+	0xd9, 0x1b, 0xf4, 0x7b, 0x83, 0xce, 0x33, 0xfe, 0xad, 0x51, 0x43, 0x11, 0x4f, 0x5b, 0x7a, 0xaf,
+	0xd5, 0xee, 0x77, 0xb2, 0x22, 0xea, 0xa8, 0xc6, 0xd1, 0xe9, 0xe1, 0x88, 0xc2, 0x54, 0x02, 0x50,
+	0x1d, 0x1e, 0x76, 0x3b, 0x4f, 0x5a, 0x0d, 0x88, 0x9f, 0x5b, 0xfd, 0x96, 0xde, 0x58, 0xc1, 0xe7,
+	0xd3, 0xf6, 0x8f, 0x3a, 0x87, 0xe7, 0x8d, 0x55, 0xb2, 0x09, 0x0d, 0xaa, 0x58, 0x56, 0xce, 0x1a,
+	0x2e, 0xd0, 0xd2, 0x4f, 0x46, 0x45, 0x1d, 0xd7, 0xc9, 0x1a, 0xa8, 0xbd, 0xc1, 0x79, 0x47, 0x3f,
+	0x6e, 0x1d, 0x76, 0x1a, 0x6f, 0xe0, 0xb6, 0x46, 0x03, 0xfc, 0xd2, 0x20, 0x75, 0x90, 0x3b, 0x83,
+	0xd1, 0x93, 0xc6, 0x3d, 0xb2, 0x0e, 0x80, 0x4f, 0xcf, 0x9e, 0xb6, 0xfa, 0xa3, 0x4e, 0x83, 0xa0,
+	0x35, 0x7a, 0x83, 0xb3, 0xd1, 0xf9, 0x33, 0xb6, 0xe8, 0x06, 0xd9, 0x86, 0xad, 0xf8, 0xcd, 0x8d,
+	0xa5, 0x37, 0xb5, 0x0b, 0xa8, 0x73, 0xef, 0x92, 0x2d, 0xa8, 0x3a, 0xbe, 0x11, 0x58, 0x2e, 0x73,
+	0x21, 0xa3, 0xc8, 0xbb, 0x2c, 0x38, 0xe2, 0xf3, 0x91, 0x14, 0x9c, 0xd8, 0x0a, 0xae, 0x58, 0xaf,
+	0xb9, 0x05, 0xd5, 0x20, 0xe6, 0x8f, 0xcf, 0x45, 0x46, 0x69, 0x9f, 0x02, 0x0c, 0xe9, 0x04, 0x83,
+	0x13, 0x0a, 0xa2, 0xd8, 0xd8, 0xc3, 0x56, 0x89, 0x29, 0x4c, 0xb2, 0xc0, 0xf3, 0xa2, 0x53, 0x3f,
+	0x64, 0x21, 0xdf, 0x2c, 0xeb, 0xd9, 0x68, 0x6b, 0xcb, 0x81, 0xda, 0x00, 0x25, 0x1b, 0x8e, 0x11,
+	0xa4, 0x92, 0x91, 0x4a, 0x25, 0x23, 0x75, 0xfb, 0x66, 0x4c, 0xfb, 0xa3, 0x00, 0x70, 0x7a, 0xf1,
+	0xdc, 0x32, 0x23, 0x2e, 0xd0, 0xa3, 0x14, 0x17, 0x18, 0x53, 0x78, 0x94, 0xd8, 0x13, 0xdf, 0xc1,
+	0xcc, 0x8a, 0x67, 0x20, 0x4e, 0x92, 0x0f, 0x01, 0x6c, 0x37, 0xb2, 0x82, 0x4b, 0xc3, 0x4c, 0x06,
+	0xc2, 0xf2, 0x05, 0x33, 0x38, 0xf2, 0x30, 0x29, 0xaa, 0xf2, 0x82, 0x9d, 0x33, 0x9c, 0xf6, 0x67,
+	0x01, 0xd6, 0x7a, 0x5c, 0x00, 0xd5, 0xf5, 0x2d, 0x50, 0x13, 0x89, 0x4c, 0xdd, 0xf4, 0x45, 0x56,
+	0x63, 0x69, 0x9e, 0xc6, 0xf2, 0xd2, 0x1a, 0x8b, 0xb7, 0xd4, 0xf8, 0x13, 0x50, 0x47, 0xae, 0xed,
+	0xb9, 0x54, 0xd9, 0x4d, 0x50, 0xa6, 0x48, 0xf0, 0x5a, 0x41, 0x09, 0xb2, 0x07, 0xb5, 0x89, 0x35,
+	0xb9, 0xb0, 0x82, 0xb0, 0x29, 0xce, 0xd1, 0x83, 0x83, 0xb4, 0x33, 0xa8, 0x77, 0xdc, 0xe9, 0x84,
+	0x4a, 0x24, 0x20, 0x5b, 0xee, 0x74, 0xc2, 0x04, 0xd2, 0x67, 0x54, 0x92, 0x9d, 0x79, 0x0b, 0x95,
+	0x8c, 0x71, 0xda, 0xa7, 0xa0, 0xd2, 0x76, 0x8f, 0x2b, 0x69, 0x23, 0xc1, 0x95, 0xa4, 0x04, 0x39,
+	0x28, 0xec, 0x7c, 0xc1, 0x14, 0xc6, 0xb7, 0xff, 0x6f, 0x01, 0xd6, 0x92, 0x72, 0xc7, 0x1d, 0x96,
+	0xb4, 0xb0, 0xdc, 0x61, 0xc9, 0x8b, 0x64, 0xd4, 0x13, 0x6f, 0x3f, 0xea, 0x7d, 0x0b, 0x20, 0xb0,
+	0x7c, 0xcb, 0x88, 0x68, 0x67, 0xa4, 0xd0, 0x29, 0x3c, 0xf3, 0x86, 0xbc, 0x03, 0x6b, 0x29, 0x85,
+	0x91, 0x50, 0xa5, 0x8b, 0xe6, 0x5f, 0xe2, 0xae, 0x3d, 0x37, 0x8d, 0x93, 0x98, 0x20, 0x1f, 0x82,
+	0xec, 0x78, 0x26, 0x8f, 0x8f, 0x9d, 0x45, 0x75, 0x5c, 0xa7, 0x68, 0xed, 0x4f, 0x32, 0xd4, 0x71,
+	0xaf, 0x43, 0xdf, 0x32, 0x97, 0x98, 0xff, 0x1f, 0x25, 0x15, 0x22, 0xde, 0x7d, 0xb1, 0x23, 0x4f,
+	0x8b, 0x09, 0x76, 0x85, 0x31, 0x34, 0x66, 0xa2, 0xc9, 0x2f, 0xcd, 0x60, 0xe2, 0x75, 0x22, 0x66,
+	0x42, 0x0a, 0x99, 0x58, 0x82, 0xcb, 0xa5, 0x4c, 0x69, 0x2d, 0xe8, 0x56, 0x92, 0xec, 0x7f, 0x9c,
+	0xcd, 0x34, 0xa5, 0xb4, 0x33, 0xcc, 0xa5, 0x26, 0x76, 0x86, 0x69, 0x26, 0x3e, 0xe4, 0xa1, 0x5f,
+	0x2d, 0x8d, 0xc9, 0x24, 0x47, 0xb0, 0x11, 0x88, 0xd3, 0xe2, 0x01, 0x0b, 0xed, 0x5a, 0xe9, 0xd9,
+	0xcc, 0x33, 0x00, 0x9b, 0x01, 0x16, 0xf5, 0x2c, 0x6c, 0xeb, 0xa5, 0x0b, 0x24, 0xf1, 0x8d, 0x0b,
+	0xc4, 0x21, 0xfd, 0x38, 0x1b, 0x89, 0x6a, 0xe9, 0x86, 0x72, 0xa1, 0x8b, 0x1b, 0x4a, 0x23, 0x35,
+	0x3f, 0xb9, 0xc1, 0xdd, 0x86, 0xfa, 0x9f, 0x0b, 0x70, 0x0f, 0x45, 0x77, 0x5e, 0x46, 0x96, 0x1b,
+	0xda, 0x9e, 0x4b, 0x43, 0x66, 0x0b, 0xaa, 0x91, 0x77, 0x9d, 0x36, 0x30, 0x8c, 0xc2, 0x46, 0x32,
+	0xf2, 0xae, 0xe7, 0xce, 0x41, 0x08, 0x20, 0xef, 0xc7, 0xd2, 0x67, 0x74, 0x35, 0x3c, 0x32, 0xf5,
+	0x58, 0x85, 0xff, 0x08, 0x50, 0xe7, 0x57, 0x68, 0xcb, 0x8c, 0xfa, 0xa9, 0x92, 0x62, 0x99, 0x92,
+	0xd2, 0x22, 0x25, 0x0f, 0xa0, 0x1e, 0x31, 0x4d, 0xe6, 0x5c, 0x04, 0xe2, 0x67, 0x1c, 0x67, 0x38,
+	0x94, 0x1c, 0xc1, 0x4a, 0x14, 0x1b, 0x8c, 0x72, 0x2a, 0xa5, 0xf3, 0xc5, 0x0d, 0x93, 0x76, 0x2b,
+	0x7a, 0x96, 0x0d, 0xed, 0x1f, 0xfa, 0x96, 0xa9, 0xfd, 0x4e, 0x00, 0xd2, 0x79, 0x69, 0x99, 0x53,
+	0x5a, 0x07, 0xbe, 0xac, 0xeb, 0xd1, 0x0e, 0xac, 0x8c, 0xad, 0x4b, 0xdb, 0xb5, 0xb1, 0x26, 0xf0,
+	0xe3, 0xf0, 0xdb, 0xc5, 0x00, 0x4e, 0x97, 0x4d, 0xb0, 0x7a, 0x96, 0x4f, 0xfb, 0x9b, 0x00, 0x9b,
+	0x65, 0xa8, 0x65, 0xbc, 0xd4, 0x06, 0xd5, 0xf3, 0xad, 0x80, 0x56, 0x27, 0xa6, 0xbb, 0x56, 0x4c,
+	0x76, 0xfe, 0x3d, 0x5d, 0x01, 0x23, 0x3d, 0x61, 0x23, 0x1f, 0x43, 0xfd, 0x32, 0x30, 0xae, 0x26,
+	0xe9, 0x6d, 0xcd, 0xdb, 0xc5, 0x13, 0x85, 0x7d, 0xce, 0x49, 0x48, 0x98, 0xda, 0xab, 0x00, 0xe9,
+	0xbe, 0xb4, 0x5f, 0x49, 0xb0, 0x51, 0xb2, 0x26, 0xad, 0xc0, 0x7e, 0xa6, 0x6b, 0xa7, 0x04, 0x79,
+	0x0c, 0xa2, 0xe7, 0xb3, 0x90, 0xbf, 0xbf, 0x58, 0xf3, 0xf4, 0x9d, 0x2e, 0x7a, 0x7e, 0x52, 0x7c,
+	0xa5, 0x85, 0xc5, 0xf7, 0x10, 0x54, 0x3e, 0x32, 0xf3, 0x76, 0xe4, 0x3b, 0x33, 0xc6, 0xeb, 0x74,
+	0x35, 0x7a, 0x0a, 0xa5, 0x7c, 0x85, 0x9a, 0xa0, 0x2c, 0x77, 0x2f, 0xfe, 0x31, 0xac, 0x86, 0x96,
+	0x83, 0x94, 0xe7, 0x0e, 0x2d, 0x7e, 0x11, 0x55, 0x64, 0x1f, 0x66, 0x20, 0x7a, 0x8e, 0x41, 0xfb,
+	0x08, 0xd4, 0x64, 0xf7, 0xe9, 0xd4, 0x50, 0xc9, 0x4d, 0x0d, 0xc2, 0x8d, 0xa9, 0x41, 0xd4, 0x3e,
+	0x17, 0x81, 0xdc, 0xf4, 0x20, 0x5e, 0xc9, 0x24, 0x6e, 0x67, 0x57, 0x32, 0x9c, 0x5e, 0xe2, 0x9e,
+	0xaf, 0xfc, 0x5c, 0x7d, 0x18, 0x27, 0xff, 0xa1, 0xe7, 0x8e, 0xe7, 0xdd, 0xd0, 0xea, 0x09, 0xea,
+	0xff, 0x6c, 0xda, 0xcf, 0x25, 0x20, 0x37, 0xdd, 0x4f, 0x1e, 0x65, 0xae, 0x64, 0x84, 0xb9, 0x57,
+	0x32, 0x99, 0x0b, 0x99, 0x64, 0x1c, 0x16, 0x97, 0x19, 0x87, 0xa5, 0xa5, 0xc6, 0x75, 0xf9, 0x2e,
+	0x37, 0x9f, 0xca, 0xdd, 0x6f, 0x3e, 0xab, 0xaf, 0x7b, 0xf3, 0x59, 0x7b, 0xed, 0x9b, 0xcf, 0x5f,
+	0x08, 0xb0, 0x55, 0x9e, 0xa1, 0x73, 0xef, 0xfb, 0xb2, 0x57, 0xa0, 0x6f, 0x2f, 0x4c, 0xf8, 0x85,
+	0x57, 0xa1, 0x7d, 0xa8, 0x73, 0x2e, 0x3c, 0x21, 0xc7, 0x9e, 0x93, 0x99, 0xcb, 0x62, 0x6a, 0x89,
+	0xb9, 0xcc, 0x87, 0xd5, 0x6c, 0x50, 0xce, 0xd9, 0xc8, 0xfd, 0xdc, 0x46, 0x9a, 0xb3, 0x22, 0x7b,
+	0xa1, 0xfe, 0x5f, 0x08, 0xa0, 0x26, 0x68, 0x72, 0x00, 0x0a, 0xed, 0xe3, 0x9b, 0x42, 0x69, 0x3b,
+	0x4e, 0x07, 0x89, 0x04, 0x8d, 0x31, 0x4b, 0xd1, 0xe4, 0x04, 0xd6, 0x79, 0xa5, 0x18, 0xfa, 0x81,
+	0x65, 0x8c, 0x67, 0xb4, 0xf3, 0xc7, 0x39, 0x50, 0xb7, 0xa2, 0x17, 0xd8, 0x50, 0x90, 0xed, 0x3a,
+	0xb6, 0x6b, 0x1d, 0xe7, 0xcf, 0x9f, 0x9b, 0x73, 0x41, 0x16, 0x84, 0x82, 0xf2, 0x6c, 0xed, 0x15,
+	0x50, 0x93, 0x7c, 0xd6, 0x7e, 0x23, 0xc2, 0x7a, 0x5e, 0x75, 0xf2, 0x5d, 0x50, 0x0c, 0xc7, 0x36,
+	0xc2, 0xb9, 0x5d, 0x7a, 0x0c, 0x59, 0xa2, 0xf6, 0x2d, 0x73, 0xbb, 0x54, 0xc8, 0x07, 0xf9, 0xf5,
+	0x0a, 0x9c, 0xb2, 0x6c, 0x81, 0xfb, 0xb5, 0x00, 0xeb, 0x79, 0x77, 0xd0, 0x6b, 0x85, 0xd8, 0x7b,
+	0xfc, 0x5a, 0x21, 0x7e, 0x7f, 0xfb, 0xfd, 0xbf, 0xce, 0x6f, 0x62, 0xed, 0x5f, 0x02, 0xfe, 0xca,
+	0xc8, 0x7a, 0x71, 0xa6, 0x46, 0xc9, 0x19, 0x23, 0xce, 0x3a, 0x63, 0xa4, 0x3b, 0x9c, 0x31, 0xff,
+	0x63, 0x17, 0xb4, 0x95, 0x9f, 0x48, 0x46, 0x18, 0x5d, 0x54, 0x29, 0xf6, 0xd1, 0x7f, 0x07, 0x00,
+	0x39, 0xbf, 0xe3, 0xae, 0xd7, 0x1f, 0x00, 0x00,
 }
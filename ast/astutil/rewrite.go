@@ -0,0 +1,513 @@
+// Package astutil contains common utilities for working with the GraphQL AST.
+package astutil
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gqlc/graphql/ast"
+)
+
+// An ApplyFunc is invoked by Apply for each node n, even if n is nil,
+// before and/or after the node's children, using a Cursor describing
+// the current node and providing operations on it.
+//
+// The return value of ApplyFunc controls the syntax tree traversal.
+// See Apply for details.
+//
+type ApplyFunc func(*Cursor) bool
+
+// Apply traverses a syntax tree recursively, starting with root,
+// and calling pre and post for each node as described below.
+// Apply returns the syntax tree, possibly modified.
+//
+// If pre is not nil, it is called for each node before the node's
+// children are traversed (pre-order). If pre returns false, no
+// children are traversed, and post is not called for that node.
+//
+// If post is not nil, and a prior call of pre didn't return false,
+// post is called for each node after its children are traversed
+// (post-order). If post returns false, traversal is terminated and
+// Apply returns immediately.
+//
+// Only fields that refer to AST nodes are considered children;
+// i.e., DocGroup_Docs are children of DocGroups, but token.Pos values
+// and the Name of a DirectiveLit are not. Children are traversed in
+// the same order as by ast.Walk, except that the top-level directives
+// of a Document are traversed before its type declarations.
+//
+// Nodes wrapped by a oneof (e.g. the *ast.NonNull of an ast.Field_NonNull)
+// are traversed as children of the message declaring the oneof; the
+// wrappers are never exposed. Cursor.Replace wraps the new node as needed.
+//
+// Children are traversed in order, and modifications to the
+// current node's children are reflected in the traversal.
+// The same is true for nodes added before or after the current
+// node by Cursor.InsertBefore and Cursor.InsertAfter.
+//
+func Apply(root ast.Node, pre, post ApplyFunc) (result ast.Node) {
+	parent := &struct{ ast.Node }{root}
+	defer func() {
+		if r := recover(); r != nil && r != abort {
+			panic(r)
+		}
+		result = parent.Node
+	}()
+	a := &application{pre: pre, post: post}
+	a.apply(parent, "Node", nil, root)
+	return
+}
+
+var abort = new(int) // singleton, to signal termination of Apply
+
+// A Cursor describes a node encountered during Apply.
+// Information about the node and its parent is available
+// from the Node, Parent, Name, and Index methods.
+//
+// If p is a variable of type and value of the current parent node
+// c.Parent(), and f is the field identifier with name c.Name(),
+// the following invariants hold:
+//
+//   p.f            == c.Node()  if c.Index() <  0
+//   p.f[c.Index()] == c.Node()  if c.Index() >= 0
+//
+// where p.f refers to the node wrapped by the oneof, if f is a oneof.
+//
+// The methods Replace, Delete, InsertBefore, and InsertAfter
+// can be used to change the AST without disrupting Apply.
+//
+type Cursor struct {
+	parent ast.Node
+	name   string
+	iter   *iterator // valid if non-nil
+	node   ast.Node
+}
+
+// Node returns the current Node.
+func (c *Cursor) Node() ast.Node { return c.node }
+
+// Parent returns the parent of the current Node.
+func (c *Cursor) Parent() ast.Node { return c.parent }
+
+// Name returns the name of the parent Node field that contains the current Node.
+// For a node wrapped by a oneof, Name returns the name of the oneof e.g. "Type"
+// for the *ast.NonNull of an ast.Field_NonNull.
+//
+func (c *Cursor) Name() string { return c.name }
+
+// Index reports the index >= 0 of the current Node in the slice of Nodes that
+// contains it, or a value < 0 if the current Node is not part of a slice.
+// The index of the current node changes if InsertBefore is called while
+// processing the current node.
+//
+func (c *Cursor) Index() int {
+	if c.iter != nil {
+		return c.iter.index
+	}
+	return -1
+}
+
+// field returns the current node's parent field value.
+func (c *Cursor) field() reflect.Value {
+	return fieldByName(c.parent, c.name)
+}
+
+// Replace replaces the current Node with n.
+// The replacement node is not walked by Apply.
+//
+func (c *Cursor) Replace(n ast.Node) {
+	v := c.field()
+	if i := c.Index(); i >= 0 {
+		v = v.Index(i)
+	}
+	v.Set(value(c.parent, v.Type(), n))
+	c.sync(c.node, n)
+	c.node = n
+}
+
+// Delete deletes the current Node from its containing slice.
+// If the current Node is not part of a slice, Delete panics.
+//
+func (c *Cursor) Delete() {
+	i := c.Index()
+	if i < 0 {
+		panic("Delete node not contained in slice")
+	}
+	v := c.field()
+	l := v.Len()
+	reflect.Copy(v.Slice(i, l), v.Slice(i+1, l))
+	v.Index(l - 1).Set(reflect.Zero(v.Type().Elem()))
+	v.SetLen(l - 1)
+	c.iter.step--
+	c.sync(c.node, nil)
+}
+
+// InsertAfter inserts n after the current Node in its containing slice.
+// If the current Node is not part of a slice, InsertAfter panics.
+// Apply does not walk n.
+//
+func (c *Cursor) InsertAfter(n ast.Node) {
+	i := c.Index()
+	if i < 0 {
+		panic("InsertAfter node not contained in slice")
+	}
+	v := c.field()
+	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+	l := v.Len()
+	reflect.Copy(v.Slice(i+2, l), v.Slice(i+1, l))
+	v.Index(i + 1).Set(value(c.parent, v.Type().Elem(), n))
+	c.iter.step++
+	c.sync(nil, n)
+}
+
+// InsertBefore inserts n before the current Node in its containing slice.
+// If the current Node is not part of a slice, InsertBefore panics.
+// Apply will not walk n.
+//
+func (c *Cursor) InsertBefore(n ast.Node) {
+	i := c.Index()
+	if i < 0 {
+		panic("InsertBefore node not contained in slice")
+	}
+	v := c.field()
+	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+	l := v.Len()
+	reflect.Copy(v.Slice(i+1, l), v.Slice(i, l))
+	v.Index(i).Set(value(c.parent, v.Type().Elem(), n))
+	c.iter.index++
+	c.sync(nil, n)
+}
+
+// sync keeps fields which alias the replaced node old up to date. They are
+// Document.Schema, for the schema declaration in Document.Types, and
+// ScalarType.Name, which is shared with the Name of the enclosing TypeSpec.
+//
+func (c *Cursor) sync(old, n ast.Node) {
+	switch p := c.parent.(type) {
+	case *ast.Document:
+		if c.name != "Types" {
+			return
+		}
+		if old != nil && old == ast.Node(p.Schema) {
+			p.Schema = nil
+		}
+		if td, ok := n.(*ast.TypeDecl); ok && p.Schema == nil && isSchema(td) {
+			p.Schema = td
+		}
+	case *ast.TypeSpec:
+		sc, ok := p.Type.(*ast.TypeSpec_Scalar)
+		if !ok || c.name != "Name" || sc.Scalar == nil || ast.Node(sc.Scalar.Name) != old {
+			return
+		}
+		id, _ := n.(*ast.Ident)
+		sc.Scalar.Name = id
+	}
+}
+
+func isSchema(td *ast.TypeDecl) bool {
+	ts, ok := td.Spec.(*ast.TypeDecl_TypeSpec)
+	if !ok || ts.TypeSpec == nil {
+		return false
+	}
+	_, ok = ts.TypeSpec.Type.(*ast.TypeSpec_Schema)
+	return ok
+}
+
+// fieldByName returns the addressable field of parent with the given name.
+// The values of a ListLit are held by the message wrapped by its oneof.
+//
+func fieldByName(parent ast.Node, name string) reflect.Value {
+	if l, ok := parent.(*ast.ListLit); ok && name == "Values" {
+		switch v := l.List.(type) {
+		case *ast.ListLit_BasicList:
+			return reflect.ValueOf(v.BasicList).Elem().FieldByName(name)
+		case *ast.ListLit_CompositeList:
+			return reflect.ValueOf(v.CompositeList).Elem().FieldByName(name)
+		}
+	}
+	return reflect.ValueOf(parent).Elem().FieldByName(name)
+}
+
+// value returns n as a value assignable to a field of type typ.
+// If typ is a oneof, n is wrapped by the matching oneof wrapper of parent.
+//
+func value(parent ast.Node, typ reflect.Type, n ast.Node) reflect.Value {
+	if n == nil || reflect.ValueOf(n).IsNil() {
+		return reflect.Zero(typ)
+	}
+
+	v := reflect.ValueOf(n)
+	if v.Type().AssignableTo(typ) {
+		return v
+	}
+
+	if typ.Kind() == reflect.Interface {
+		wp, ok := parent.(interface{ XXX_OneofWrappers() []interface{} })
+		if ok {
+			for _, w := range wp.XXX_OneofWrappers() {
+				wt := reflect.TypeOf(w)
+				if !wt.Implements(typ) || wt.Elem().Field(0).Type != v.Type() {
+					continue
+				}
+
+				wv := reflect.New(wt.Elem())
+				wv.Elem().Field(0).Set(v)
+				return wv
+			}
+		}
+	}
+	panic(fmt.Sprintf("astutil: cannot use %T as %s of %T", n, typ, parent))
+}
+
+// unwrap returns the node wrapped by the oneof x; or nil.
+func unwrap(x interface{}) ast.Node {
+	if x == nil {
+		return nil
+	}
+	n, _ := reflect.ValueOf(x).Elem().Field(0).Interface().(ast.Node)
+	return n
+}
+
+type application struct {
+	pre, post ApplyFunc
+	cursor    Cursor
+	iter      iterator
+}
+
+func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.Node) {
+	// convert typed nil into untyped nil
+	if v := reflect.ValueOf(n); v.Kind() == reflect.Ptr && v.IsNil() {
+		n = nil
+	}
+
+	// avoid heap-allocating a new cursor for each apply call; reuse a.cursor instead
+	saved := a.cursor
+	a.cursor.parent = parent
+	a.cursor.name = name
+	a.cursor.iter = iter
+	a.cursor.node = n
+
+	if a.pre != nil && !a.pre(&a.cursor) {
+		a.cursor = saved
+		return
+	}
+
+	// walk children
+	// (the order of the cases matches the order of the corresponding node types in ast.Walk)
+	switch n := n.(type) {
+	case nil:
+		// nothing to do
+
+	case *ast.Document:
+		a.apply(n, "Doc", nil, n.Doc)
+		if n.Schema != nil && !contains(n.Types, n.Schema) {
+			a.apply(n, "Schema", nil, n.Schema)
+		}
+		a.applyList(n, "Directives")
+		a.applyList(n, "Types")
+
+	case *ast.DocGroup:
+		a.applyList(n, "List")
+
+	case *ast.DocGroup_Doc:
+		// nothing to do
+
+	case *ast.Arg:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Value", nil, unwrap(n.Value))
+
+	case *ast.Field:
+		a.apply(n, "Doc", nil, n.Doc)
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Args", nil, n.Args)
+		a.apply(n, "Type", nil, unwrap(n.Type))
+		a.applyList(n, "Directives")
+
+	case *ast.FieldList:
+		a.applyList(n, "List")
+
+	case *ast.InputValue:
+		a.apply(n, "Doc", nil, n.Doc)
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Type", nil, unwrap(n.Type))
+		a.apply(n, "Default", nil, unwrap(n.Default))
+		a.applyList(n, "Directives")
+
+	case *ast.InputValueList:
+		a.applyList(n, "List")
+
+	case *ast.Ident, *ast.BasicLit:
+		// nothing to do
+
+	case *ast.CompositeLit:
+		a.apply(n, "Value", nil, unwrap(n.Value))
+
+	case *ast.ListLit:
+		if n.List != nil {
+			a.applyList(n, "Values")
+		}
+
+	case *ast.ObjLit:
+		a.applyList(n, "Fields")
+
+	case *ast.ObjLit_Pair:
+		a.apply(n, "Key", nil, n.Key)
+		a.apply(n, "Val", nil, n.Val)
+
+	case *ast.List:
+		a.apply(n, "Type", nil, unwrap(n.Type))
+
+	case *ast.NonNull:
+		a.apply(n, "Type", nil, unwrap(n.Type))
+
+	case *ast.DirectiveLit:
+		a.apply(n, "Args", nil, n.Args)
+
+	case *ast.DirectiveLocation:
+		// nothing to do
+
+	case *ast.CallExpr:
+		a.applyList(n, "Args")
+
+	case *ast.SchemaType:
+		a.apply(n, "RootOps", nil, n.RootOps)
+
+	case *ast.ScalarType:
+		// Name is shared with the enclosing TypeSpec,
+		// so it has already been traversed.
+
+	case *ast.ObjectType:
+		a.applyList(n, "Interfaces")
+		a.apply(n, "Fields", nil, n.Fields)
+
+	case *ast.InterfaceType:
+		a.applyList(n, "Interfaces")
+		a.apply(n, "Fields", nil, n.Fields)
+
+	case *ast.UnionType:
+		a.applyList(n, "Members")
+
+	case *ast.EnumType:
+		a.apply(n, "Values", nil, n.Values)
+
+	case *ast.InputType:
+		a.apply(n, "Fields", nil, n.Fields)
+
+	case *ast.DirectiveType:
+		a.apply(n, "Args", nil, n.Args)
+		a.applyList(n, "Locs")
+
+	case *ast.TypeSpec:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Directives")
+		a.apply(n, "Type", nil, unwrap(n.Type))
+
+	case *ast.TypeExtensionSpec:
+		a.apply(n, "Type", nil, n.Type)
+
+	case *ast.TypeDecl:
+		a.apply(n, "Doc", nil, n.Doc)
+		a.apply(n, "Spec", nil, unwrap(n.Spec))
+
+	case *ast.ExecutableDocument:
+		a.apply(n, "Doc", nil, n.Doc)
+		a.applyList(n, "Definitions")
+
+	case *ast.ExecutableDefinition:
+		a.apply(n, "Doc", nil, n.Doc)
+		a.apply(n, "Definition", nil, unwrap(n.Definition))
+
+	case *ast.OperationDefinition:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Variables", nil, n.Variables)
+		a.applyList(n, "Directives")
+		a.apply(n, "SelectionSet", nil, n.SelectionSet)
+
+	case *ast.FragmentDefinition:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "TypeCond", nil, n.TypeCond)
+		a.applyList(n, "Directives")
+		a.apply(n, "SelectionSet", nil, n.SelectionSet)
+
+	case *ast.VariableDefinition:
+		a.apply(n, "Variable", nil, n.Variable)
+		a.apply(n, "Type", nil, unwrap(n.Type))
+		a.apply(n, "Default", nil, unwrap(n.Default))
+		a.applyList(n, "Directives")
+
+	case *ast.VariableDefinitionList:
+		a.applyList(n, "List")
+
+	case *ast.Variable:
+		a.apply(n, "Name", nil, n.Name)
+
+	case *ast.SelectionSet:
+		a.applyList(n, "List")
+
+	case *ast.Selection:
+		a.apply(n, "Selection", nil, unwrap(n.Selection))
+
+	case *ast.FieldSelection:
+		a.apply(n, "Alias", nil, n.Alias)
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Args", nil, n.Args)
+		a.applyList(n, "Directives")
+		a.apply(n, "SelectionSet", nil, n.SelectionSet)
+
+	case *ast.FragmentSpread:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Directives")
+
+	case *ast.InlineFragment:
+		a.apply(n, "TypeCond", nil, n.TypeCond)
+		a.applyList(n, "Directives")
+		a.apply(n, "SelectionSet", nil, n.SelectionSet)
+
+	default:
+		panic(fmt.Sprintf("Apply: unexpected node type %T", n))
+	}
+
+	if a.post != nil && !a.post(&a.cursor) {
+		panic(abort)
+	}
+
+	a.cursor = saved
+}
+
+// An iterator controls iteration over a slice of nodes.
+type iterator struct {
+	index, step int
+}
+
+func (a *application) applyList(parent ast.Node, name string) {
+	// avoid heap-allocating a new iterator for each applyList call; reuse a.iter instead
+	saved := a.iter
+	a.iter.index = 0
+	for {
+		// must reload parent.name each time, since cursor modifications might change it
+		v := fieldByName(parent, name)
+		if a.iter.index >= v.Len() {
+			break
+		}
+
+		// element x may be nil in a bad AST - be cautious
+		var x ast.Node
+		if e := v.Index(a.iter.index); e.IsValid() {
+			x = e.Interface().(ast.Node)
+		}
+
+		a.iter.step = 1
+		a.apply(parent, name, &a.iter, x)
+		a.iter.index += a.iter.step
+	}
+	a.iter = saved
+}
+
+func contains(types []*ast.TypeDecl, td *ast.TypeDecl) bool {
+	for _, t := range types {
+		if t == td {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+package astutil_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/ast/astutil"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/printer"
+	"github.com/gqlc/graphql/token"
+)
+
+func TestApply(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Src  string
+		Pre  astutil.ApplyFunc
+		Post astutil.ApplyFunc
+		Out  string
+	}{
+		{
+			Name: "ReplaceFieldType",
+			Src:  `type A { b: B }`,
+			Pre: func(c *astutil.Cursor) bool {
+				if id, ok := c.Node().(*ast.Ident); ok && c.Name() == "Type" {
+					c.Replace(&ast.NonNull{Type: &ast.NonNull_Ident{Ident: id}})
+				}
+				return true
+			},
+			Out: `type A {
+  b: B!
+}`,
+		},
+		{
+			Name: "ReplaceNilDefault",
+			Src:  `input A { b: Int c: Int = 2 }`,
+			Pre: func(c *astutil.Cursor) bool {
+				if _, ok := c.Parent().(*ast.InputValue); ok && c.Name() == "Default" && c.Node() == nil {
+					c.Replace(&ast.BasicLit{Kind: token.INT, Value: "1"})
+				}
+				return true
+			},
+			Out: `input A {
+  b: Int = 1
+  c: Int = 2
+}`,
+		},
+		{
+			Name: "DeleteDirective",
+			Src:  `type A @a @b { c: C @b @a }`,
+			Pre: func(c *astutil.Cursor) bool {
+				if d, ok := c.Node().(*ast.DirectiveLit); ok && d.Name == "b" {
+					c.Delete()
+				}
+				return true
+			},
+			Out: `type A @a {
+  c: C @a
+}`,
+		},
+		{
+			Name: "InsertField",
+			Src:  `type A { b: B d: D }`,
+			Pre: func(c *astutil.Cursor) bool {
+				f, ok := c.Node().(*ast.Field)
+				if !ok {
+					return true
+				}
+
+				switch f.Name.Name {
+				case "b":
+					c.InsertAfter(&ast.Field{
+						Name: &ast.Ident{Name: "c"},
+						Type: &ast.Field_Ident{Ident: &ast.Ident{Name: "C"}},
+					})
+				case "d":
+					c.InsertBefore(&ast.Field{
+						Name: &ast.Ident{Name: "cc"},
+						Type: &ast.Field_Ident{Ident: &ast.Ident{Name: "C"}},
+					})
+				}
+				return false
+			},
+			Out: `type A {
+  b: B
+  c: C
+  cc: C
+  d: D
+}`,
+		},
+		{
+			Name: "DeleteTypeDecl",
+			Src: `scalar A
+type B { a: A }
+scalar C`,
+			Pre: func(c *astutil.Cursor) bool {
+				td, ok := c.Node().(*ast.TypeDecl)
+				if ok && td.Spec.(*ast.TypeDecl_TypeSpec).TypeSpec.Name.Name == "B" {
+					c.Delete()
+				}
+				return true
+			},
+			Out: `scalar A
+
+scalar C`,
+		},
+		{
+			Name: "ListValues",
+			Src:  `type A @a(b: [1, 2, 3]) { c: C }`,
+			Pre: func(c *astutil.Cursor) bool {
+				if _, ok := c.Parent().(*ast.ListLit); !ok {
+					return true
+				}
+				if v, ok := c.Node().(*ast.CompositeLit).Value.(*ast.CompositeLit_BasicLit); ok && v.BasicLit.Value == "2" {
+					c.Delete()
+				}
+				return true
+			},
+			Out: `type A @a(b: [1, 3]) {
+  c: C
+}`,
+		},
+		{
+			Name: "RenameScalar",
+			Src:  `scalar A`,
+			Pre: func(c *astutil.Cursor) bool {
+				if _, ok := c.Parent().(*ast.TypeSpec); ok && c.Name() == "Name" {
+					c.Replace(&ast.Ident{Name: "B"})
+				}
+				return true
+			},
+			Out: `scalar B`,
+		},
+		{
+			Name: "Abort",
+			Src:  `type A { b: B c: C }`,
+			Post: func(c *astutil.Cursor) bool {
+				if id, ok := c.Node().(*ast.Ident); ok && c.Name() == "Type" {
+					id.Name = "X"
+					return false
+				}
+				return true
+			},
+			Out: `type A {
+  b: X
+  c: C
+}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(testCase.Src), 0)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+
+			res := astutil.Apply(doc, testCase.Pre, testCase.Post)
+			if res != ast.Node(doc) {
+				subT.Error("expected root to be unchanged")
+				return
+			}
+
+			var b bytes.Buffer
+			err = printer.Fprint(&b, nil, doc)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+
+			if out := strings.TrimSuffix(b.String(), "\n"); out != testCase.Out {
+				subT.Errorf("mismatched output:\nexpected:\n%s\ngot:\n%s", testCase.Out, out)
+			}
+		})
+	}
+}
+
+func TestApplySchema(t *testing.T) {
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(`schema { query: Q } type Q { a: A }`), 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	astutil.Apply(doc, func(c *astutil.Cursor) bool {
+		if c.Node() == ast.Node(doc.Schema) {
+			c.Delete()
+		}
+		return true
+	}, nil)
+
+	if doc.Schema != nil {
+		t.Error("expected schema to be removed")
+	}
+	if len(doc.Types) != 1 {
+		t.Errorf("expected 1 type decl; got: %d", len(doc.Types))
+	}
+}
+
+func TestApplyReplaceRoot(t *testing.T) {
+	doc := &ast.Document{Name: "a"}
+	res := astutil.Apply(doc, func(c *astutil.Cursor) bool {
+		if _, ok := c.Node().(*ast.Document); ok {
+			c.Replace(&ast.Document{Name: "b"})
+		}
+		return false
+	}, nil)
+
+	if res.(*ast.Document).Name != "b" {
+		t.Errorf("expected root to be replaced")
+	}
+}
+
+func TestApplyBadReplace(t *testing.T) {
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(`type A { b: B }`), 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic")
+		}
+	}()
+	astutil.Apply(doc, func(c *astutil.Cursor) bool {
+		if c.Name() == "Type" {
+			c.Replace(&ast.BasicLit{})
+		}
+		return true
+	}, nil)
+}
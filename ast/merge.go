@@ -0,0 +1,306 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/gqlc/graphql/token"
+)
+
+// Origins maps the nodes merged into a definition by MergeExtensions
+// to the document in which they were declared.
+//
+type Origins map[Node]*Document
+
+// A MergeError describes a type extension, or part of one, which
+// could not be merged into its definition.
+//
+type MergeError struct {
+	Doc      *Document      // document declaring the offending node
+	Pos      token.Pos      // position of the offending node
+	Position token.Position // full position of the offending node
+	Msg      string
+}
+
+// Error implements the error interface.
+func (e *MergeError) Error() string {
+	if e.Position.Filename != "" || e.Position.IsValid() {
+		return e.Position.String() + ": " + e.Msg
+	}
+	if e.Doc != nil && e.Doc.Name != "" {
+		return e.Doc.Name + ": " + e.Msg
+	}
+	return e.Msg
+}
+
+// MergeErrorList is a list of *MergeErrors.
+type MergeErrorList []*MergeError
+
+// A MergeErrorList implements the error interface.
+func (p MergeErrorList) Error() string {
+	switch len(p) {
+	case 0:
+		return "no errors"
+	case 1:
+		return p[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", p[0], len(p)-1)
+}
+
+// Err returns an error equivalent to this error list.
+// If the list is empty, Err returns nil.
+func (p MergeErrorList) Err() error {
+	if len(p) == 0 {
+		return nil
+	}
+	return p
+}
+
+// MergeExtensions folds the type extensions declared in the given documents
+// into the definitions they extend, which may be declared in any of the
+// documents. The directives, fields, interfaces, union members, enum values,
+// input fields and root operations of an extension are appended to its
+// definition, and the extension is removed from its document. The docs of
+// a removed extension i.e. the comments preceding it are dropped with it,
+// since their positions lie in the extension's document; the docs of its
+// fields, values etc. are kept with them.
+//
+// An extension of an undefined type, or of a type of a different kind, is
+// reported and left in place. A field, value, member, interface or root
+// operation that is already declared by the definition is reported and not
+// merged; the rest of its extension is merged regardless.
+//
+// The returned Origins maps every merged node to the document declaring it.
+// If any conflicts are found, the error is a MergeErrorList, whose positions
+// are resolved against dset, if it's not nil.
+//
+func MergeExtensions(dset *token.DocSet, docs ...*Document) (Origins, error) {
+	m := &merger{
+		dset:    dset,
+		defs:    make(map[string]*TypeSpec),
+		origins: make(Origins),
+	}
+	for _, doc := range docs {
+		for _, td := range doc.Types {
+			ts := td.GetTypeSpec()
+			if ts == nil {
+				continue
+			}
+
+			switch ts.Type.(type) {
+			case *TypeSpec_Schema:
+				if m.schema == nil {
+					m.schema = ts
+				}
+				continue
+			case *TypeSpec_Directive:
+				// directives can't be extended and may share a name with a type
+				continue
+			}
+			if _, exists := m.defs[ts.Name.Name]; !exists {
+				m.defs[ts.Name.Name] = ts
+			}
+		}
+	}
+
+	for _, doc := range docs {
+		m.doc = doc
+		types := doc.Types[:0]
+		for _, td := range doc.Types {
+			ext := td.GetTypeExtSpec()
+			if ext == nil || !m.merge(td, ext.Type) {
+				types = append(types, td)
+			}
+		}
+		for i := len(types); i < len(doc.Types); i++ {
+			doc.Types[i] = nil
+		}
+		doc.Types = types
+	}
+	return m.origins, m.errs.Err()
+}
+
+type merger struct {
+	dset    *token.DocSet
+	defs    map[string]*TypeSpec
+	schema  *TypeSpec
+	doc     *Document // document of the extension being merged
+	origins Origins
+	errs    MergeErrorList
+}
+
+func (m *merger) errorf(pos token.Pos, format string, args ...interface{}) {
+	e := &MergeError{Doc: m.doc, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+	if m.dset != nil {
+		e.Position = m.dset.Position(pos)
+	}
+	m.errs = append(m.errs, e)
+}
+
+// merge merges the extension ext, declared by td, into its
+// definition and reports whether it could be merged.
+//
+func (m *merger) merge(td *TypeDecl, ext *TypeSpec) bool {
+	if e, ok := ext.Type.(*TypeSpec_Schema); ok {
+		if m.schema == nil {
+			m.errorf(td.Pos(), "cannot extend undefined schema")
+			return false
+		}
+		m.directives(m.schema, ext)
+
+		def := m.schema.GetSchema()
+		if e.Schema.RootOps == nil {
+			return true
+		}
+		if def.RootOps == nil {
+			def.RootOps = &FieldList{}
+		}
+		def.RootOps.List = m.fields("root operation", "", def.RootOps.List, e.Schema.RootOps.List)
+		return true
+	}
+
+	name := ext.Name.Name
+	def, ok := m.defs[name]
+	if !ok {
+		m.errorf(ext.Pos(), "cannot extend undefined type %s", name)
+		return false
+	}
+	if kind(def) != kind(ext) {
+		m.errorf(ext.Pos(), "cannot extend %s %s with %s extension", kind(def), name, kind(ext))
+		return false
+	}
+	m.directives(def, ext)
+
+	switch e := ext.Type.(type) {
+	case *TypeSpec_Object:
+		d := def.GetObject()
+		d.Interfaces = m.idents("interface", name, d.Interfaces, e.Object.Interfaces)
+		if e.Object.Fields != nil {
+			if d.Fields == nil {
+				d.Fields = &FieldList{}
+			}
+			d.Fields.List = m.fields("field", name+".", d.Fields.List, e.Object.Fields.List)
+		}
+	case *TypeSpec_Interface:
+		d := def.GetInterface()
+		d.Interfaces = m.idents("interface", name, d.Interfaces, e.Interface.Interfaces)
+		if e.Interface.Fields != nil {
+			if d.Fields == nil {
+				d.Fields = &FieldList{}
+			}
+			d.Fields.List = m.fields("field", name+".", d.Fields.List, e.Interface.Fields.List)
+		}
+	case *TypeSpec_Union:
+		d := def.GetUnion()
+		d.Members = m.idents("union member", name, d.Members, e.Union.Members)
+	case *TypeSpec_Enum:
+		d := def.GetEnum()
+		if e.Enum.Values != nil {
+			if d.Values == nil {
+				d.Values = &FieldList{}
+			}
+			d.Values.List = m.fields("enum value", name+".", d.Values.List, e.Enum.Values.List)
+		}
+	case *TypeSpec_Input:
+		d := def.GetInput()
+		if e.Input.Fields != nil {
+			if d.Fields == nil {
+				d.Fields = &InputValueList{}
+			}
+			d.Fields.List = m.inputValues(name, d.Fields.List, e.Input.Fields.List)
+		}
+	}
+	return true
+}
+
+func (m *merger) directives(def, ext *TypeSpec) {
+	for _, d := range ext.Directives {
+		m.origins[d] = m.doc
+	}
+	def.Directives = append(def.Directives, ext.Directives...)
+}
+
+func (m *merger) idents(what, owner string, list, ext []*Ident) []*Ident {
+	for _, id := range ext {
+		if containsIdent(list, id.Name) {
+			m.errorf(id.Pos(), "duplicate %s of %s: %s", what, owner, id.Name)
+			continue
+		}
+		m.origins[id] = m.doc
+		list = append(list, id)
+	}
+	return list
+}
+
+func (m *merger) fields(what, owner string, list, ext []*Field) []*Field {
+	for _, f := range ext {
+		if containsField(list, f.Name.Name) {
+			m.errorf(f.Name.Pos(), "duplicate %s: %s%s", what, owner, f.Name.Name)
+			continue
+		}
+		m.origins[f] = m.doc
+		list = append(list, f)
+	}
+	return list
+}
+
+func (m *merger) inputValues(owner string, list, ext []*InputValue) []*InputValue {
+	for _, f := range ext {
+		if containsInputValue(list, f.Name.Name) {
+			m.errorf(f.Name.Pos(), "duplicate input field: %s.%s", owner, f.Name.Name)
+			continue
+		}
+		m.origins[f] = m.doc
+		list = append(list, f)
+	}
+	return list
+}
+
+func containsIdent(list []*Ident, name string) bool {
+	for _, id := range list {
+		if id.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsField(list []*Field, name string) bool {
+	for _, f := range list {
+		if f.Name.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInputValue(list []*InputValue, name string) bool {
+	for _, f := range list {
+		if f.Name.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// kind returns the keyword declaring the given type spec.
+func kind(ts *TypeSpec) string {
+	switch ts.Type.(type) {
+	case *TypeSpec_Schema:
+		return "schema"
+	case *TypeSpec_Scalar:
+		return "scalar"
+	case *TypeSpec_Object:
+		return "type"
+	case *TypeSpec_Interface:
+		return "interface"
+	case *TypeSpec_Union:
+		return "union"
+	case *TypeSpec_Enum:
+		return "enum"
+	case *TypeSpec_Input:
+		return "input"
+	case *TypeSpec_Directive:
+		return "directive"
+	}
+	return ""
+}
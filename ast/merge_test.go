@@ -0,0 +1,226 @@
+package ast_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/token"
+)
+
+// summary describes the declarations of doc, one per line.
+func summary(doc *ast.Document) string {
+	var b strings.Builder
+	for _, td := range doc.Types {
+		ts := td.GetTypeSpec()
+		if ts == nil {
+			ts = td.GetTypeExtSpec().Type
+			b.WriteString("extend ")
+		}
+
+		var names []string
+		for _, d := range ts.Directives {
+			names = append(names, "@"+d.Name)
+		}
+		for _, id := range append(ts.GetObject().GetInterfaces(), ts.GetInterface().GetInterfaces()...) {
+			names = append(names, "&"+id.Name)
+		}
+		for _, id := range ts.GetUnion().GetMembers() {
+			names = append(names, "|"+id.Name)
+		}
+		fields := ts.GetObject().GetFields().GetList()
+		fields = append(fields, ts.GetInterface().GetFields().GetList()...)
+		fields = append(fields, ts.GetEnum().GetValues().GetList()...)
+		fields = append(fields, ts.GetSchema().GetRootOps().GetList()...)
+		for _, f := range fields {
+			names = append(names, f.Name.Name)
+		}
+		for _, f := range ts.GetInput().GetFields().GetList() {
+			names = append(names, f.Name.Name)
+		}
+
+		name := "schema"
+		if ts.Name != nil {
+			name = ts.Name.Name
+		}
+		fmt.Fprintf(&b, "%s: %s\n", name, strings.Join(names, " "))
+	}
+	return b.String()
+}
+
+func TestMergeExtensions(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Srcs []string
+		Out  []string
+		Errs []string
+	}{
+		{
+			Name: "Object",
+			Srcs: []string{`type A implements I @a { b: Int }
+extend type A implements J @c { d: Int }
+extend type A { e: Int }`},
+			Out: []string{"A: @a @c &I &J b d e\n"},
+		},
+		{
+			Name: "AcrossDocuments",
+			Srcs: []string{
+				`extend interface I { b: Int }
+extend enum E @d { C }`,
+				`interface I { a: Int }
+enum E { A B }
+extend union U = Y
+union U = X
+extend input In { b: Int }
+input In
+scalar S
+extend scalar S @s`,
+			},
+			Out: []string{
+				"",
+				"I: a b\nE: @d A B C\nU: |X |Y\nIn: b\nS: @s\n",
+			},
+		},
+		{
+			Name: "Schema",
+			Srcs: []string{`schema { query: Q }
+extend schema @a { mutation: M }`},
+			Out: []string{"schema: @a query mutation\n"},
+		},
+		{
+			Name: "Conflicts",
+			Srcs: []string{
+				`type A implements I { b: Int }
+enum E { V }
+input In { a: Int }
+union U = X
+schema { query: Q }`,
+				`extend type A implements I { b: Int, c: Int }
+extend enum E { V }
+extend input In { a: Int }
+extend union U = X
+extend schema { query: Q }
+extend type Undefined { a: Int }
+extend union A = B`,
+			},
+			Out: []string{
+				"A: &I b c\nE: V\nIn: a\nU: |X\nschema: query\n",
+				"extend Undefined: a\nextend A: |B\n",
+			},
+			Errs: []string{
+				"doc1:1:26: duplicate interface of A: I",
+				"doc1:1:30: duplicate field: A.b",
+				"doc1:2:17: duplicate enum value: E.V",
+				"doc1:3:19: duplicate input field: In.a",
+				"doc1:4:18: duplicate union member of U: X",
+				"doc1:5:17: duplicate root operation: query",
+				"doc1:6:13: cannot extend undefined type Undefined",
+				"doc1:7:14: cannot extend type A with union extension",
+			},
+		},
+		{
+			Name: "DirectiveNamedLikeType",
+			Srcs: []string{
+				`directive @Foo on OBJECT
+type Foo { a: Int }
+extend type Foo { b: Int }`,
+				`type Bar { a: Int }
+directive @Bar on OBJECT
+extend type Bar { b: Int }`,
+			},
+			Out: []string{
+				"Foo: \nFoo: a b\n",
+				"Bar: a b\nBar: \n",
+			},
+		},
+		{
+			Name: "UndefinedSchema",
+			Srcs: []string{`extend schema { query: Q }`},
+			Out:  []string{"extend schema: query\n"},
+			Errs: []string{"doc0:1:1: cannot extend undefined schema"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			dset := token.NewDocSet()
+			docs := make([]*ast.Document, len(testCase.Srcs))
+			for i, src := range testCase.Srcs {
+				doc, err := parser.ParseDoc(dset, fmt.Sprintf("doc%d", i), strings.NewReader(src), 0)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				docs[i] = doc
+			}
+
+			_, err := ast.MergeExtensions(dset, docs...)
+
+			var errs []string
+			if err != nil {
+				for _, e := range err.(ast.MergeErrorList) {
+					errs = append(errs, e.Error())
+				}
+			}
+			if strings.Join(errs, "\n") != strings.Join(testCase.Errs, "\n") {
+				subT.Errorf("expected errors:\n%s\ngot:\n%s", strings.Join(testCase.Errs, "\n"), strings.Join(errs, "\n"))
+			}
+
+			for i, doc := range docs {
+				if s := summary(doc); s != testCase.Out[i] {
+					subT.Errorf("doc%d: expected:\n%s\ngot:\n%s", i, testCase.Out[i], s)
+				}
+			}
+		})
+	}
+
+	t.Run("WithoutDocSet", func(subT *testing.T) {
+		doc, err := parser.ParseDoc(token.NewDocSet(), "doc", strings.NewReader(`extend scalar S @d`), 0)
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		_, err = ast.MergeExtensions(nil, doc)
+		if err == nil || err.Error() != "doc: cannot extend undefined type S" {
+			subT.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestMergeExtensionsOrigins(t *testing.T) {
+	dset := token.NewDocSet()
+	a, err := parser.ParseDoc(dset, "a", strings.NewReader(`type A { a: Int }`), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := parser.ParseDoc(dset, "b", strings.NewReader(`extend type A @d { b: Int }`), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	origins, err := ast.MergeExtensions(dset, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := a.Types[0].GetTypeSpec()
+	fields := ts.GetObject().Fields.List
+	if len(fields) != 2 {
+		t.Fatalf("expected 2 fields, got: %d", len(fields))
+	}
+	if _, ok := origins[fields[0]]; ok {
+		t.Error("expected no origin for a field of the definition")
+	}
+	if origins[fields[1]] != b {
+		t.Errorf("expected field b to originate from document b, got: %v", origins[fields[1]])
+	}
+	if origins[ts.Directives[0]] != b {
+		t.Errorf("expected directive @d to originate from document b, got: %v", origins[ts.Directives[0]])
+	}
+
+	pos := dset.Position(fields[1].Name.Pos())
+	if pos.Filename != "b" || pos.Line != 1 || pos.Column != 20 {
+		t.Errorf("expected merged field to keep its position, got: %s", pos)
+	}
+}
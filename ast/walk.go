@@ -0,0 +1,455 @@
+package ast
+
+import "fmt"
+
+// A Visitor's Visit method is invoked for each node encountered by Walk.
+// If the result visitor w is not nil, Walk visits each of the children
+// of node with the visitor w, followed by a call of w.Visit(nil).
+//
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Helper functions for common node lists. They may be empty.
+
+func walkIdentList(v Visitor, list []*Ident) {
+	for _, x := range list {
+		Walk(v, x)
+	}
+}
+
+func walkDirectiveList(v Visitor, list []*DirectiveLit) {
+	for _, x := range list {
+		Walk(v, x)
+	}
+}
+
+func walkDocGroup(v Visitor, dg *DocGroup) {
+	if dg != nil {
+		Walk(v, dg)
+	}
+}
+
+// walkOneof walks the node wrapped by a oneof field, if any.
+func walkOneof(v Visitor, x interface{}) {
+	var n Node
+	switch x := x.(type) {
+	case nil:
+	case *Field_Ident:
+		n = x.Ident
+	case *Field_List:
+		n = x.List
+	case *Field_NonNull:
+		n = x.NonNull
+	case *InputValue_Ident:
+		n = x.Ident
+	case *InputValue_List:
+		n = x.List
+	case *InputValue_NonNull:
+		n = x.NonNull
+	case *InputValue_BasicLit:
+		n = x.BasicLit
+	case *InputValue_CompositeLit:
+		n = x.CompositeLit
+	case *List_Ident:
+		n = x.Ident
+	case *List_List:
+		n = x.List
+	case *List_NonNull:
+		n = x.NonNull
+	case *NonNull_Ident:
+		n = x.Ident
+	case *NonNull_List:
+		n = x.List
+	case *Arg_BasicLit:
+		n = x.BasicLit
+	case *Arg_CompositeLit:
+		n = x.CompositeLit
+	case *Arg_Variable:
+		n = x.Variable
+	case *CompositeLit_BasicLit:
+		n = x.BasicLit
+	case *CompositeLit_ListLit:
+		n = x.ListLit
+	case *CompositeLit_ObjLit:
+		n = x.ObjLit
+	case *CompositeLit_Variable:
+		n = x.Variable
+	case *TypeSpec_Schema:
+		n = x.Schema
+	case *TypeSpec_Scalar:
+		n = x.Scalar
+	case *TypeSpec_Object:
+		n = x.Object
+	case *TypeSpec_Interface:
+		n = x.Interface
+	case *TypeSpec_Union:
+		n = x.Union
+	case *TypeSpec_Enum:
+		n = x.Enum
+	case *TypeSpec_Input:
+		n = x.Input
+	case *TypeSpec_Directive:
+		n = x.Directive
+	case *TypeDecl_TypeSpec:
+		n = x.TypeSpec
+	case *TypeDecl_TypeExtSpec:
+		n = x.TypeExtSpec
+	case *ExecutableDefinition_Operation:
+		n = x.Operation
+	case *ExecutableDefinition_Fragment:
+		n = x.Fragment
+	case *VariableDefinition_Ident:
+		n = x.Ident
+	case *VariableDefinition_List:
+		n = x.List
+	case *VariableDefinition_NonNull:
+		n = x.NonNull
+	case *VariableDefinition_BasicLit:
+		n = x.BasicLit
+	case *VariableDefinition_CompositeLit:
+		n = x.CompositeLit
+	case *Selection_Field:
+		n = x.Field
+	case *Selection_FragmentSpread:
+		n = x.FragmentSpread
+	case *Selection_InlineFragment:
+		n = x.InlineFragment
+	default:
+		panic(fmt.Sprintf("ast.Walk: unexpected oneof type %T", x))
+	}
+	Walk(v, n)
+}
+
+// Walk traverses an AST in depth-first order: It starts by calling v.Visit(node);
+// node must not be nil. If the visitor w returned by v.Visit(node) is not nil,
+// Walk is invoked recursively with visitor w for each of the non-nil children
+// of node, followed by a call of w.Visit(nil).
+//
+// Children are visited in source order, with two exceptions: the Doc of a
+// Document is visited before any of its declarations, and the Directives
+// of a TypeSpec are visited before its type e.g. before the interfaces
+// implemented by an ObjectType.
+//
+// The oneof wrapper types (e.g. Field_NonNull) are not nodes themselves;
+// Walk visits the node they wrap instead.
+//
+func Walk(v Visitor, node Node) {
+	if node == nil {
+		return
+	}
+	if v = v.Visit(node); v == nil {
+		return
+	}
+
+	// walk children
+	// (the order of the cases matches the order
+	// of the corresponding message declarations in ast.proto)
+	switch n := node.(type) {
+	case *Document:
+		walkDocGroup(v, n.Doc)
+		walkTopLevel(v, n)
+
+	case *DocGroup:
+		for _, d := range n.List {
+			Walk(v, d)
+		}
+
+	case *DocGroup_Doc:
+		// nothing to do
+
+	case *Arg:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		walkOneof(v, n.Value)
+
+	case *Field:
+		walkDocGroup(v, n.Doc)
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		if n.Args != nil {
+			Walk(v, n.Args)
+		}
+		walkOneof(v, n.Type)
+		walkDirectiveList(v, n.Directives)
+
+	case *FieldList:
+		for _, f := range n.List {
+			Walk(v, f)
+		}
+
+	case *InputValue:
+		walkDocGroup(v, n.Doc)
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		walkOneof(v, n.Type)
+		walkOneof(v, n.Default)
+		walkDirectiveList(v, n.Directives)
+
+	case *InputValueList:
+		for _, a := range n.List {
+			Walk(v, a)
+		}
+
+	case *Ident, *BasicLit:
+		// nothing to do
+
+	case *CompositeLit:
+		walkOneof(v, n.Value)
+
+	case *ListLit:
+		switch l := n.List.(type) {
+		case *ListLit_BasicList:
+			for _, x := range l.BasicList.Values {
+				Walk(v, x)
+			}
+		case *ListLit_CompositeList:
+			for _, x := range l.CompositeList.Values {
+				Walk(v, x)
+			}
+		}
+
+	case *ObjLit:
+		for _, p := range n.Fields {
+			Walk(v, p)
+		}
+
+	case *ObjLit_Pair:
+		if n.Key != nil {
+			Walk(v, n.Key)
+		}
+		if n.Val != nil {
+			Walk(v, n.Val)
+		}
+
+	case *List:
+		walkOneof(v, n.Type)
+
+	case *NonNull:
+		walkOneof(v, n.Type)
+
+	case *DirectiveLit:
+		if n.Args != nil {
+			Walk(v, n.Args)
+		}
+
+	case *DirectiveLocation:
+		// nothing to do
+
+	case *CallExpr:
+		for _, a := range n.Args {
+			Walk(v, a)
+		}
+
+	case *SchemaType:
+		if n.RootOps != nil {
+			Walk(v, n.RootOps)
+		}
+
+	case *ScalarType:
+		// Name is shared with the enclosing TypeSpec,
+		// so it has already been visited.
+
+	case *ObjectType:
+		walkIdentList(v, n.Interfaces)
+		if n.Fields != nil {
+			Walk(v, n.Fields)
+		}
+
+	case *InterfaceType:
+		walkIdentList(v, n.Interfaces)
+		if n.Fields != nil {
+			Walk(v, n.Fields)
+		}
+
+	case *UnionType:
+		walkIdentList(v, n.Members)
+
+	case *EnumType:
+		if n.Values != nil {
+			Walk(v, n.Values)
+		}
+
+	case *InputType:
+		if n.Fields != nil {
+			Walk(v, n.Fields)
+		}
+
+	case *DirectiveType:
+		if n.Args != nil {
+			Walk(v, n.Args)
+		}
+		for _, l := range n.Locs {
+			Walk(v, l)
+		}
+
+	case *TypeSpec:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		walkDirectiveList(v, n.Directives)
+		walkOneof(v, n.Type)
+
+	case *TypeExtensionSpec:
+		if n.Type != nil {
+			Walk(v, n.Type)
+		}
+
+	case *TypeDecl:
+		walkDocGroup(v, n.Doc)
+		walkOneof(v, n.Spec)
+
+	case *ExecutableDocument:
+		walkDocGroup(v, n.Doc)
+		for _, d := range n.Definitions {
+			Walk(v, d)
+		}
+
+	case *ExecutableDefinition:
+		walkDocGroup(v, n.Doc)
+		walkOneof(v, n.Definition)
+
+	case *OperationDefinition:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		if n.Variables != nil {
+			Walk(v, n.Variables)
+		}
+		walkDirectiveList(v, n.Directives)
+		if n.SelectionSet != nil {
+			Walk(v, n.SelectionSet)
+		}
+
+	case *FragmentDefinition:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		if n.TypeCond != nil {
+			Walk(v, n.TypeCond)
+		}
+		walkDirectiveList(v, n.Directives)
+		if n.SelectionSet != nil {
+			Walk(v, n.SelectionSet)
+		}
+
+	case *VariableDefinition:
+		if n.Variable != nil {
+			Walk(v, n.Variable)
+		}
+		walkOneof(v, n.Type)
+		walkOneof(v, n.Default)
+		walkDirectiveList(v, n.Directives)
+
+	case *VariableDefinitionList:
+		for _, d := range n.List {
+			Walk(v, d)
+		}
+
+	case *Variable:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+
+	case *SelectionSet:
+		for _, s := range n.List {
+			Walk(v, s)
+		}
+
+	case *Selection:
+		walkOneof(v, n.Selection)
+
+	case *FieldSelection:
+		if n.Alias != nil {
+			Walk(v, n.Alias)
+		}
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		if n.Args != nil {
+			Walk(v, n.Args)
+		}
+		walkDirectiveList(v, n.Directives)
+		if n.SelectionSet != nil {
+			Walk(v, n.SelectionSet)
+		}
+
+	case *FragmentSpread:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		walkDirectiveList(v, n.Directives)
+
+	case *InlineFragment:
+		if n.TypeCond != nil {
+			Walk(v, n.TypeCond)
+		}
+		walkDirectiveList(v, n.Directives)
+		if n.SelectionSet != nil {
+			Walk(v, n.SelectionSet)
+		}
+
+	default:
+		panic(fmt.Sprintf("ast.Walk: unexpected node type %T", n))
+	}
+
+	v.Visit(nil)
+}
+
+// walkTopLevel walks the top-level directives and type declarations
+// of doc in source order. If doc.Schema is not one of doc.Types,
+// it is walked first.
+//
+func walkTopLevel(v Visitor, doc *Document) {
+	types := doc.Types
+	if doc.Schema != nil && !containsDecl(types, doc.Schema) {
+		Walk(v, doc.Schema)
+	}
+
+	var i, j int
+	for i < len(doc.Directives) && j < len(types) {
+		if types[j].Pos() < doc.Directives[i].Pos() {
+			Walk(v, types[j])
+			j++
+			continue
+		}
+		Walk(v, doc.Directives[i])
+		i++
+	}
+	for ; i < len(doc.Directives); i++ {
+		Walk(v, doc.Directives[i])
+	}
+	for ; j < len(types); j++ {
+		Walk(v, types[j])
+	}
+}
+
+func containsDecl(types []*TypeDecl, td *TypeDecl) bool {
+	for _, t := range types {
+		if t == td {
+			return true
+		}
+	}
+	return false
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses an AST in depth-first order: It starts by calling
+// f(node); node must not be nil. If f returns true, Inspect invokes f
+// recursively for each of the non-nil children of node, followed by a
+// call of f(nil).
+//
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
@@ -0,0 +1,273 @@
+package ast_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/token"
+)
+
+// nodeList collects the visited nodes and checks that every
+// visited node is eventually followed by a Visit(nil).
+//
+type nodeList struct {
+	nodes []ast.Node
+	depth *int
+}
+
+func (l *nodeList) Visit(n ast.Node) ast.Visitor {
+	if n == nil {
+		*l.depth--
+		return nil
+	}
+	*l.depth++
+	l.nodes = append(l.nodes, n)
+	return l
+}
+
+func TestWalk(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Src   string
+		Nodes []string
+	}{
+		{
+			Name: "Object",
+			Src: `"desc"
+type A implements B @c(d: [1, {e: F}]) {
+	g(h: [I!] = null): J!
+}`,
+			Nodes: []string{
+				"*ast.Document",
+				"*ast.TypeDecl",
+				"*ast.DocGroup",
+				"*ast.DocGroup_Doc",
+				"*ast.TypeSpec",
+				"*ast.Ident A",
+				"*ast.DirectiveLit",
+				"*ast.CallExpr",
+				"*ast.Arg",
+				"*ast.Ident d",
+				"*ast.CompositeLit",
+				"*ast.ListLit",
+				"*ast.CompositeLit",
+				"*ast.BasicLit 1",
+				"*ast.CompositeLit",
+				"*ast.ObjLit",
+				"*ast.ObjLit_Pair",
+				"*ast.Ident e",
+				"*ast.CompositeLit",
+				"*ast.BasicLit F",
+				"*ast.ObjectType",
+				"*ast.Ident B",
+				"*ast.FieldList",
+				"*ast.Field",
+				"*ast.Ident g",
+				"*ast.InputValueList",
+				"*ast.InputValue",
+				"*ast.Ident h",
+				"*ast.List",
+				"*ast.NonNull",
+				"*ast.Ident I",
+				"*ast.BasicLit null",
+				"*ast.NonNull",
+				"*ast.Ident J",
+			},
+		},
+		{
+			Name: "TopLevel",
+			Src: `@a
+scalar B
+@c
+extend union D = E
+directive @f on FIELD`,
+			Nodes: []string{
+				"*ast.Document",
+				"*ast.DirectiveLit",
+				"*ast.TypeDecl",
+				"*ast.TypeSpec",
+				"*ast.Ident B",
+				"*ast.DirectiveLit",
+				"*ast.ScalarType",
+				"*ast.TypeDecl",
+				"*ast.TypeExtensionSpec",
+				"*ast.TypeSpec",
+				"*ast.Ident D",
+				"*ast.UnionType",
+				"*ast.Ident E",
+				"*ast.TypeDecl",
+				"*ast.TypeSpec",
+				"*ast.Ident f",
+				"*ast.DirectiveType",
+				"*ast.DirectiveLocation",
+			},
+		},
+		{
+			Name: "SchemaEnumInput",
+			Src: `schema { query: Q }
+enum E { A @b }
+input I { c: C = {d: 1} }`,
+			Nodes: []string{
+				"*ast.Document",
+				"*ast.TypeDecl",
+				"*ast.TypeSpec",
+				"*ast.SchemaType",
+				"*ast.FieldList",
+				"*ast.Field",
+				"*ast.Ident query",
+				"*ast.Ident Q",
+				"*ast.TypeDecl",
+				"*ast.TypeSpec",
+				"*ast.Ident E",
+				"*ast.EnumType",
+				"*ast.FieldList",
+				"*ast.Field",
+				"*ast.Ident A",
+				"*ast.DirectiveLit",
+				"*ast.TypeDecl",
+				"*ast.TypeSpec",
+				"*ast.Ident I",
+				"*ast.InputType",
+				"*ast.InputValueList",
+				"*ast.InputValue",
+				"*ast.Ident c",
+				"*ast.Ident C",
+				"*ast.CompositeLit",
+				"*ast.ObjLit",
+				"*ast.ObjLit_Pair",
+				"*ast.Ident d",
+				"*ast.CompositeLit",
+				"*ast.BasicLit 1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(testCase.Src), parser.ParseComments)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+
+			var depth int
+			l := &nodeList{depth: &depth}
+			ast.Walk(l, doc)
+			if depth != 0 {
+				subT.Errorf("unbalanced Visit(nil) calls: %d", depth)
+			}
+
+			compareNodes(subT, l.nodes, testCase.Nodes)
+		})
+	}
+}
+
+func TestWalkQuery(t *testing.T) {
+	src := `query Q($a: [A] = [1]) @b {
+	c: d(e: $a) { ...F ... on G { h } }
+}
+fragment F on G { i }`
+
+	doc, err := parser.ParseQuery(token.NewDocSet(), "test", strings.NewReader(src), 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	var depth int
+	l := &nodeList{depth: &depth}
+	ast.Walk(l, doc)
+	if depth != 0 {
+		t.Errorf("unbalanced Visit(nil) calls: %d", depth)
+	}
+
+	compareNodes(t, l.nodes, []string{
+		"*ast.ExecutableDocument",
+		"*ast.ExecutableDefinition",
+		"*ast.OperationDefinition",
+		"*ast.Ident Q",
+		"*ast.VariableDefinitionList",
+		"*ast.VariableDefinition",
+		"*ast.Variable",
+		"*ast.Ident a",
+		"*ast.List",
+		"*ast.Ident A",
+		"*ast.CompositeLit",
+		"*ast.ListLit",
+		"*ast.CompositeLit",
+		"*ast.BasicLit 1",
+		"*ast.DirectiveLit",
+		"*ast.SelectionSet",
+		"*ast.Selection",
+		"*ast.FieldSelection",
+		"*ast.Ident c",
+		"*ast.Ident d",
+		"*ast.CallExpr",
+		"*ast.Arg",
+		"*ast.Ident e",
+		"*ast.Variable",
+		"*ast.Ident a",
+		"*ast.SelectionSet",
+		"*ast.Selection",
+		"*ast.FragmentSpread",
+		"*ast.Ident F",
+		"*ast.Selection",
+		"*ast.InlineFragment",
+		"*ast.Ident G",
+		"*ast.SelectionSet",
+		"*ast.Selection",
+		"*ast.FieldSelection",
+		"*ast.Ident h",
+		"*ast.ExecutableDefinition",
+		"*ast.FragmentDefinition",
+		"*ast.Ident F",
+		"*ast.Ident G",
+		"*ast.SelectionSet",
+		"*ast.Selection",
+		"*ast.FieldSelection",
+		"*ast.Ident i",
+	})
+}
+
+func TestInspect(t *testing.T) {
+	src := `type A { b(c: C): B @d } type E { f: F }`
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(src), 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// Collect field names without descending into fields
+	var names []string
+	ast.Inspect(doc, func(n ast.Node) bool {
+		if f, ok := n.(*ast.Field); ok {
+			names = append(names, f.Name.Name)
+			return false
+		}
+		return true
+	})
+
+	if strings.Join(names, ",") != "b,f" {
+		t.Errorf("expected fields b,f; got: %v", names)
+	}
+}
+
+func compareNodes(t *testing.T, nodes []ast.Node, ex []string) {
+	out := make([]string, len(nodes))
+	for i, n := range nodes {
+		out[i] = fmt.Sprintf("%T", n)
+		switch x := n.(type) {
+		case *ast.Ident:
+			out[i] += " " + x.Name
+		case *ast.BasicLit:
+			out[i] += " " + x.Value
+		}
+	}
+
+	if strings.Join(out, "\n") != strings.Join(ex, "\n") {
+		t.Errorf("mismatched nodes:\nexpected:\n%s\ngot:\n%s", strings.Join(ex, "\n"), strings.Join(out, "\n"))
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Gqlfetch fetches the schema of a GraphQL server by introspection.
+
+It sends the standard introspection query to the server's endpoint, as
+a JSON POST request or, if the server doesn't allow that, as a GET request,
+and prints the schema as a formatted GraphQL schema document to standard
+output. The declarations of the prelude, such as the built-in scalars and
+the introspection types, are left out.
+
+Usage:
+	gqlfetch [flags] endpoint
+
+The flags are:
+	-H "name: value"
+		Add a header to the request, e.g. for authorization.
+		May be repeated.
+	-o file
+		Write the schema to file instead of standard output.
+		The file is only written if the schema was fetched.
+	-timeout duration
+		Time limit for fetching the schema; 0 means none.
+		The default is 30s.
+
+The features of the introspection query which older servers may not support
+are selected by flags, too:
+	-descriptions
+		Descriptions of types, fields, arguments, etc. Set by default;
+		use -descriptions=false to turn them off.
+	-schema-description
+		Description of the schema itself.
+	-specified-by-url
+		specifiedByURL of custom scalars.
+	-repeatable
+		isRepeatable of directives.
+	-input-value-deprecation
+		Deprecated arguments and input fields.
+
+For example, to keep a schema up to date:
+
+	gqlfetch -H "Authorization: Bearer $TOKEN" -o schema.graphql https://example.com/graphql
+
+*/
+package main
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/introspect"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/prelude"
+	"github.com/gqlc/graphql/printer"
+	"github.com/gqlc/graphql/token"
+)
+
+var (
+	output  = flag.String("o", "", "write the schema to `file` instead of stdout")
+	timeout = flag.Duration("timeout", 30*time.Second, "time limit for fetching the schema; 0 means none")
+
+	descriptions          = flag.Bool("descriptions", true, "query descriptions")
+	schemaDescription     = flag.Bool("schema-description", false, "query the description of the schema")
+	specifiedByURL        = flag.Bool("specified-by-url", false, "query specifiedByURL of scalars")
+	repeatable            = flag.Bool("repeatable", false, "query isRepeatable of directives")
+	inputValueDeprecation = flag.Bool("input-value-deprecation", false, "query deprecated arguments and input fields")
+
+	header = make(http.Header)
+)
+
+func init() {
+	flag.Var(headerFlag(header), "H", "add a request header, as `\"name: value\"`; may be repeated")
+}
+
+// headerFlag adds the headers given by the -H flag to an http.Header.
+type headerFlag http.Header
+
+func (h headerFlag) String() string { return "" }
+
+func (h headerFlag) Set(s string) error {
+	i := strings.IndexByte(s, ':')
+	if i <= 0 {
+		return fmt.Errorf("invalid header %q: expected \"name: value\"", s)
+	}
+	http.Header(h).Add(strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:]))
+	return nil
+}
+
+var exitCode = 0
+
+func report(err error) {
+	var errs parser.ErrorList
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			fmt.Fprintln(os.Stderr, e)
+		}
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	exitCode = 2
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: gqlfetch [flags] endpoint\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	gqlfetchMain()
+	os.Exit(exitCode)
+}
+
+func gqlfetchMain() {
+	if flag.NArg() != 1 {
+		usage()
+		exitCode = 2
+		return
+	}
+
+	if err := fetch(context.Background(), flag.Arg(0), os.Stdout); err != nil {
+		report(err)
+	}
+}
+
+// fetch fetches the schema served at endpoint and prints it to out,
+// or the output file.
+//
+func fetch(ctx context.Context, endpoint string, out io.Writer) error {
+	opts := &introspect.FetchOptions{
+		Query: introspect.QueryOptions{
+			Descriptions:          *descriptions,
+			SchemaDescription:     *schemaDescription,
+			SpecifiedByURL:        *specifiedByURL,
+			DirectiveIsRepeatable: *repeatable,
+			InputValueDeprecation: *inputValueDeprecation,
+		},
+		Header:  header,
+		Timeout: *timeout,
+		DocSet:  token.NewDocSet(),
+	}
+	doc, err := introspect.Fetch(ctx, endpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	err = printer.Fprint(&buf, opts.DocSet, withoutPrelude(doc))
+	if err != nil {
+		return err
+	}
+
+	if *output != "" {
+		return ioutil.WriteFile(*output, buf.Bytes(), 0644)
+	}
+	_, err = out.Write(buf.Bytes())
+	return err
+}
+
+// withoutPrelude removes the declarations of the prelude from doc,
+// which every introspection result includes.
+//
+func withoutPrelude(doc *ast.Document) *ast.Document {
+	types := doc.Types[:0]
+	for _, td := range doc.Types {
+		ts := td.GetTypeSpec()
+		builtin := prelude.IsBuiltin(ts.GetName().GetName())
+		if ts.GetDirective() != nil {
+			builtin = prelude.IsBuiltinDirective(ts.Name.Name)
+		}
+		if !builtin {
+			types = append(types, td)
+		}
+	}
+	doc.Types = types
+	return doc
+}
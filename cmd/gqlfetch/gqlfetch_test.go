@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/introspect"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/token"
+)
+
+const (
+	schemaSrc = `"The root type."
+type Query {
+  "Searches for things."
+  search(term: String!): [String!]
+  old: Int @deprecated
+}
+`
+
+	// printed is schemaSrc, as introspected from newServer
+	printed = schemaSrc + `
+schema {
+  query: Query
+}
+`
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(schemaSrc), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := introspect.FromDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": `))
+		w.Write(data)
+		w.Write([]byte(`}`))
+	}))
+}
+
+func TestFetch(t *testing.T) {
+	srv := newServer(t)
+	defer srv.Close()
+
+	t.Run("Unauthorized", func(subT *testing.T) {
+		var out bytes.Buffer
+		err := fetch(context.Background(), srv.URL, &out)
+		if err == nil || !strings.Contains(err.Error(), "401 Unauthorized: unauthorized") {
+			subT.Errorf("expected unauthorized error, got: %v", err)
+		}
+		if out.Len() > 0 {
+			subT.Errorf("unexpected output: %s", out.String())
+		}
+	})
+
+	if err := flag.Set("H", "Authorization: Bearer token"); err != nil {
+		t.Fatal(err)
+	}
+	defer header.Del("Authorization")
+
+	t.Run("Stdout", func(subT *testing.T) {
+		var out bytes.Buffer
+		err := fetch(context.Background(), srv.URL, &out)
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		if out.String() != printed {
+			subT.Errorf("mismatched output:\nexpected:\n%s\ngot:\n%s", printed, out.String())
+		}
+	})
+
+	t.Run("File", func(subT *testing.T) {
+		dir, err := ioutil.TempDir("", "gqlfetch")
+		if err != nil {
+			subT.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		*output = filepath.Join(dir, "schema.graphql")
+		defer func() { *output = "" }()
+
+		var out bytes.Buffer
+		if err = fetch(context.Background(), srv.URL, &out); err != nil {
+			subT.Fatal(err)
+		}
+		if out.Len() > 0 {
+			subT.Errorf("unexpected output: %s", out.String())
+		}
+
+		b, err := ioutil.ReadFile(*output)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if string(b) != printed {
+			subT.Errorf("mismatched contents:\n%s", b)
+		}
+	})
+}
+
+func TestHeaderFlag(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Flags []string
+		Out   http.Header
+		Err   string
+	}{
+		{
+			Name:  "Single",
+			Flags: []string{"Authorization: Bearer token"},
+			Out:   http.Header{"Authorization": {"Bearer token"}},
+		},
+		{
+			Name:  "Repeated",
+			Flags: []string{"x-team:  a", "X-Team:b:c"},
+			Out:   http.Header{"X-Team": {"a", "b:c"}},
+		},
+		{
+			Name:  "Invalid",
+			Flags: []string{"Authorization"},
+			Err:   `invalid header "Authorization": expected "name: value"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			h := make(http.Header)
+			for _, f := range testCase.Flags {
+				err := headerFlag(h).Set(f)
+				if err != nil {
+					if err.Error() != testCase.Err {
+						subT.Errorf("expected error: %s, got: %s", testCase.Err, err)
+					}
+					return
+				}
+			}
+			if testCase.Err != "" {
+				subT.Fatalf("expected error: %s", testCase.Err)
+			}
+
+			if fmt.Sprint(h) != fmt.Sprint(testCase.Out) {
+				subT.Errorf("expected: %v, got: %v", testCase.Out, h)
+			}
+		})
+	}
+}
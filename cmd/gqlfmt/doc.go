@@ -0,0 +1,32 @@
+/*
+Gqlfmt formats GraphQL schema documents.
+
+Without an explicit path, it processes the standard input. Given a file,
+it operates on that file; given a directory, it operates on all .gql and
+.graphql files in that directory, recursively.
+By default, gqlfmt prints the reformatted sources to standard output.
+
+Usage:
+	gqlfmt [flags] [path ...]
+
+The flags are:
+	-d
+		Do not print reformatted sources to standard output.
+		If a file's formatting is different than gqlfmt's, print diffs
+		to standard output.
+	-l
+		Do not print reformatted sources to standard output.
+		If a file's formatting is different from gqlfmt's, print its name
+		to standard output.
+	-w
+		Do not print reformatted sources to standard output.
+		If a file's formatting is different from gqlfmt's, overwrite it
+		with gqlfmt's version.
+
+Comments and descriptions are preserved and formatting is idempotent,
+so gqlfmt can be used to enforce a single style, e.g. in CI:
+
+	test -z "$(gqlfmt -l .)"
+
+*/
+package main
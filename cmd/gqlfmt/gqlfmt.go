@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/printer"
+	"github.com/gqlc/graphql/token"
+)
+
+var (
+	list   = flag.Bool("l", false, "list files whose formatting differs from gqlfmt's")
+	write  = flag.Bool("w", false, "write result to (source) file instead of stdout")
+	doDiff = flag.Bool("d", false, "display diffs instead of rewriting files")
+)
+
+var exitCode = 0
+
+func report(err error) {
+	var errs parser.ErrorList
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			fmt.Fprintln(os.Stderr, e)
+		}
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	exitCode = 2
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: gqlfmt [flags] [path ...]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	gqlfmtMain()
+	os.Exit(exitCode)
+}
+
+func gqlfmtMain() {
+	if flag.NArg() == 0 {
+		if *write {
+			report(errors.New("error: cannot use -w with standard input"))
+			return
+		}
+		if err := processFile("<standard input>", os.Stdin, os.Stdout); err != nil {
+			report(err)
+		}
+		return
+	}
+
+	for _, path := range flag.Args() {
+		switch fi, err := os.Stat(path); {
+		case err != nil:
+			report(err)
+		case fi.IsDir():
+			processDir(path, os.Stdout)
+		default:
+			if err := processFile(path, nil, os.Stdout); err != nil {
+				report(err)
+			}
+		}
+	}
+}
+
+// processFile formats the document in filename. If in is nil,
+// the document is read from the file.
+//
+func processFile(filename string, in io.Reader, out io.Writer) error {
+	if in == nil {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	src, err := ioutil.ReadAll(in)
+	if err != nil {
+		return err
+	}
+
+	dset := token.NewDocSet()
+	doc, err := parser.ParseDoc(dset, filename, bytes.NewReader(src), parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	return handle(filename, src, dset, doc, out)
+}
+
+// processDir formats every document found in the directory tree rooted at path.
+// Files with syntax errors are reported and skipped; the others are still formatted.
+//
+func processDir(path string, out io.Writer) {
+	dset := token.NewDocSet()
+	docs, err := parser.ParseDir(dset, path, nil, parser.ParseComments)
+	if err != nil {
+		report(err)
+	}
+
+	paths := make([]string, 0, len(docs))
+	for p := range docs {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		src, err := ioutil.ReadFile(p)
+		if err != nil {
+			report(err)
+			continue
+		}
+
+		if err = handle(p, src, dset, docs[p], out); err != nil {
+			report(err)
+		}
+	}
+}
+
+// handle formats doc and compares the result against its src.
+func handle(filename string, src []byte, dset *token.DocSet, doc *ast.Document, out io.Writer) error {
+	var buf bytes.Buffer
+	err := printer.Fprint(&buf, dset, doc)
+	if err != nil {
+		return err
+	}
+	res := buf.Bytes()
+
+	if !bytes.Equal(src, res) {
+		// formatting has changed
+		if *list {
+			fmt.Fprintln(out, filename)
+		}
+		if *write {
+			fi, err := os.Stat(filename)
+			if err != nil {
+				return err
+			}
+
+			err = ioutil.WriteFile(filename, res, fi.Mode().Perm())
+			if err != nil {
+				return err
+			}
+		}
+		if *doDiff {
+			data, err := diff(src, res, filename)
+			if err != nil {
+				return fmt.Errorf("computing diff: %s", err)
+			}
+			out.Write(data)
+		}
+	}
+
+	if !*list && !*write && !*doDiff {
+		_, err = out.Write(res)
+	}
+	return err
+}
+
+// diff returns the unified diff of b1 and b2, as computed by the system diff tool.
+func diff(b1, b2 []byte, filename string) (data []byte, err error) {
+	f1, err := writeTempFile("gqlfmt", b1)
+	if err != nil {
+		return
+	}
+	defer os.Remove(f1)
+
+	f2, err := writeTempFile("gqlfmt", b2)
+	if err != nil {
+		return
+	}
+	defer os.Remove(f2)
+
+	data, err = exec.Command("diff", "-u", "-L", filename+".orig", "-L", filename, f1, f2).CombinedOutput()
+	if len(data) > 0 {
+		// diff exits with a non-zero status when the files don't match.
+		// Ignore that failure as long as we get output.
+		err = nil
+	}
+	return
+}
+
+func writeTempFile(prefix string, data []byte) (string, error) {
+	file, err := ioutil.TempFile("", prefix)
+	if err != nil {
+		return "", err
+	}
+	_, err = file.Write(data)
+	if err1 := file.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	unformatted = `# Comment
+"Description" type   Test implements A&B{a:A   b(c:C=1):B}
+`
+	formatted = `# Comment
+"Description"
+type Test implements A & B {
+  a: A
+  b(c: C = 1): B
+}
+`
+)
+
+func setFlags(l, w, d bool) func() {
+	*list, *write, *doDiff = l, w, d
+	return func() { *list, *write, *doDiff = false, false, false }
+}
+
+func TestProcessFile(t *testing.T) {
+	var out bytes.Buffer
+	err := processFile("test.gql", strings.NewReader(unformatted), &out)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if out.String() != formatted {
+		t.Errorf("mismatched output:\nexpected:\n%s\ngot:\n%s", formatted, out.String())
+	}
+
+	out.Reset()
+	err = processFile("test.gql", strings.NewReader(formatted), &out)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if out.String() != formatted {
+		t.Errorf("formatting is not idempotent:\n%s", out.String())
+	}
+}
+
+func TestProcessDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gqlfmt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"a.gql":              unformatted,
+		"ok.graphql":         formatted,
+		"sub/a.gql":          unformatted,
+		"sub/ignore.txt":     unformatted,
+		"sub/deep/b.graphql": unformatted,
+	}
+	for name, src := range files {
+		p := filepath.Join(dir, name)
+		if err = os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(p, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("List", func(subT *testing.T) {
+		defer setFlags(true, false, false)()
+
+		var out bytes.Buffer
+		processDir(dir, &out)
+
+		ex := strings.Join([]string{
+			filepath.Join(dir, "a.gql"),
+			filepath.Join(dir, "sub/a.gql"),
+			filepath.Join(dir, "sub/deep/b.graphql"),
+		}, "\n") + "\n"
+		if out.String() != ex {
+			subT.Errorf("mismatched list:\nexpected:\n%s\ngot:\n%s", ex, out.String())
+		}
+	})
+
+	t.Run("Diff", func(subT *testing.T) {
+		defer setFlags(false, false, true)()
+
+		var out bytes.Buffer
+		err := processFile(filepath.Join(dir, "a.gql"), nil, &out)
+		if err != nil {
+			subT.Skipf("diff unavailable: %s", err)
+			return
+		}
+
+		if !strings.Contains(out.String(), "+type Test implements A & B {") {
+			subT.Errorf("unexpected diff:\n%s", out.String())
+		}
+	})
+
+	t.Run("Write", func(subT *testing.T) {
+		defer setFlags(false, true, false)()
+
+		var out bytes.Buffer
+		processDir(dir, &out)
+		if out.Len() > 0 {
+			subT.Errorf("unexpected output: %s", out.String())
+		}
+
+		for name, src := range files {
+			b, err := ioutil.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+
+			ex := formatted
+			if filepath.Ext(name) == ".txt" {
+				ex = src
+			}
+			if string(b) != ex {
+				subT.Errorf("%s: mismatched contents:\n%s", name, b)
+			}
+		}
+	})
+
+	t.Run("Idempotent", func(subT *testing.T) {
+		defer setFlags(true, false, false)()
+
+		var out bytes.Buffer
+		processDir(dir, &out)
+		if out.Len() > 0 {
+			subT.Errorf("expected no unformatted files; got:\n%s", out.String())
+		}
+	})
+
+	t.Run("Errors", func(subT *testing.T) {
+		defer setFlags(true, false, false)()
+		defer func() { exitCode = 0 }()
+
+		bad := filepath.Join(dir, "sub", "bad.gql")
+		if err := ioutil.WriteFile(bad, []byte("type T {"), 0644); err != nil {
+			subT.Fatal(err)
+		}
+		defer os.Remove(bad)
+		if err := ioutil.WriteFile(filepath.Join(dir, "sub/deep/b.graphql"), []byte(unformatted), 0644); err != nil {
+			subT.Fatal(err)
+		}
+
+		stderr, err := ioutil.TempFile("", "gqlfmt")
+		if err != nil {
+			subT.Fatal(err)
+		}
+		defer os.Remove(stderr.Name())
+		defer func(f *os.File) { os.Stderr = f }(os.Stderr)
+		os.Stderr = stderr
+
+		var out bytes.Buffer
+		processDir(dir, &out)
+		stderr.Close()
+
+		ex := filepath.Join(dir, "sub/deep/b.graphql") + "\n"
+		if out.String() != ex {
+			subT.Errorf("mismatched list:\nexpected:\n%s\ngot:\n%s", ex, out.String())
+		}
+
+		msg, err := ioutil.ReadFile(stderr.Name())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !strings.HasPrefix(string(msg), bad+":1:") {
+			subT.Errorf("expected error for %s, got: %s", bad, msg)
+		}
+		if exitCode != 2 {
+			subT.Errorf("expected exit code 2, got: %d", exitCode)
+		}
+	})
+	t.Run("TrailingComments", func(subT *testing.T) {
+		defer setFlags(false, true, false)()
+
+		src := `enum E {
+  A # a
+  B
+}
+
+type T {
+  a: A # a
+  b: B
+}
+`
+		p := filepath.Join(dir, "sub", "comments.gql")
+		if err := ioutil.WriteFile(p, []byte(src), 0644); err != nil {
+			subT.Fatal(err)
+		}
+		defer os.Remove(p)
+
+		var out bytes.Buffer
+		processDir(dir, &out)
+
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if string(b) != src {
+			subT.Errorf("mismatched contents:\nexpected:\n%s\ngot:\n%s", src, b)
+		}
+	})
+}
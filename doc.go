@@ -1,3 +1,3 @@
-// Package graphql contains packages for working with the GraphQL IDL (TypeSystem spec only).
+// Package graphql contains packages for working with the GraphQL IDL and executable documents.
 //
 package graphql
@@ -0,0 +1,152 @@
+package introspect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/token"
+)
+
+// FetchOptions configure Fetch. A nil *FetchOptions selects the defaults.
+type FetchOptions struct {
+	Query   QueryOptions  // features of the introspection query
+	Header  http.Header   // additional request headers, e.g. Authorization
+	Timeout time.Duration // limit for the whole fetch; default: none
+	Client  *http.Client  // default: http.DefaultClient
+	DocSet  *token.DocSet // records the response; default: a new DocSet
+}
+
+// maxErrorBody is how much of the body of a failed response a StatusError keeps.
+const maxErrorBody = 512
+
+// A StatusError is returned by Fetch if the server responds with a status
+// other than 200 OK.
+//
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	Body       string // start of the response body
+}
+
+func (e *StatusError) Error() string {
+	msg := fmt.Sprintf("%s %s: %s", e.Method, e.URL, e.Status)
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// Fetch sends the introspection query returned by Query to the GraphQL server
+// at endpoint and parses its response with ParseIntrospection of package parser,
+// which records it in the DocSet of opts under the name endpoint.
+//
+// The query is POSTed as JSON. If the server doesn't allow that i.e. it
+// responds with 405 Method Not Allowed, the query is sent again with GET,
+// in the parameters of the URL. Any other status than 200 OK is returned
+// as a *StatusError.
+//
+func Fetch(ctx context.Context, endpoint string, opts *FetchOptions) (*ast.Document, error) {
+	if opts == nil {
+		opts = new(FetchOptions)
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	dset := opts.DocSet
+	if dset == nil {
+		dset = token.NewDocSet()
+	}
+
+	query := Query(opts.Query)
+	resp, err := send(ctx, client, http.MethodPost, endpoint, query, opts.Header)
+	if err == nil && resp.StatusCode == http.StatusMethodNotAllowed {
+		resp.Body.Close()
+		resp, err = send(ctx, client, http.MethodGet, endpoint, query, opts.Header)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody+1))
+		body := string(b)
+		if len(b) > maxErrorBody {
+			body = string(b[:maxErrorBody]) + "..."
+		}
+		return nil, &StatusError{
+			Method:     resp.Request.Method,
+			URL:        endpoint,
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       strings.TrimSpace(body),
+		}
+	}
+	return parser.ParseIntrospection(dset, endpoint, resp.Body)
+}
+
+// send sends query to endpoint as a POST or GET request, as described by
+// the GraphQL over HTTP spec.
+//
+func send(ctx context.Context, client *http.Client, method, endpoint, query string, header http.Header) (*http.Response, error) {
+	params := struct {
+		Query         string `json:"query"`
+		OperationName string `json:"operationName"`
+	}{query, "IntrospectionQuery"}
+
+	var body io.Reader
+	if method == http.MethodGet {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		vals := u.Query()
+		vals.Set("query", params.Query)
+		vals.Set("operationName", params.OperationName)
+		u.RawQuery = vals.Encode()
+		endpoint = u.String()
+	} else {
+		b, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, vals := range header {
+		req.Header.Del(key)
+		for _, v := range vals {
+			req.Header.Add(key, v)
+		}
+	}
+	if host := req.Header.Get("Host"); host != "" {
+		req.Host = host // net/http ignores the header
+	}
+	return client.Do(req)
+}
@@ -0,0 +1,208 @@
+package introspect
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/token"
+)
+
+func TestFetch(t *testing.T) {
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(testSrc), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	full, err := FromDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// answer the default query, as a server would
+	query := Query(QueryOptions{})
+	q, err := parser.ParseQuery(token.NewDocSet(), "query", strings.NewReader(query), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data interface{}
+	if err = json.Unmarshal(full, &data); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := json.Marshal(map[string]interface{}{"data": answer(t, q, data)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	respond := func(w http.ResponseWriter, params struct{ Query, OperationName string }) {
+		if params.Query != query || params.OperationName != "IntrospectionQuery" {
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(resp)
+	}
+	post := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "unexpected method", http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, "unexpected content type", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		var params struct{ Query, OperationName string }
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		respond(w, params)
+	}
+
+	testCases := []struct {
+		Name    string
+		Handler http.HandlerFunc
+		Opts    *FetchOptions
+		Err     string
+	}{
+		{
+			Name:    "Post",
+			Handler: post,
+		},
+		{
+			Name: "Header",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Accept") != "application/graphql-response+json" {
+					http.Error(w, "unauthorized", http.StatusUnauthorized)
+					return
+				}
+				post(w, r)
+			},
+			Opts: &FetchOptions{Header: http.Header{
+				"Authorization": {"Bearer token"},
+				"Accept":        {"application/graphql-response+json"},
+			}},
+		},
+		{
+			Name: "GetFallback",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					w.Header().Set("Allow", http.MethodGet)
+					http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+					return
+				}
+				vals := r.URL.Query()
+				if vals.Get("key") != "value" {
+					http.Error(w, "missing key", http.StatusBadRequest)
+					return
+				}
+				respond(w, struct{ Query, OperationName string }{vals.Get("query"), vals.Get("operationName")})
+			},
+		},
+		{
+			Name: "Status",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "something broke", http.StatusInternalServerError)
+			},
+			Err: "POST {url}: 500 Internal Server Error: something broke",
+		},
+		{
+			Name: "LongBody",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+				w.Write([]byte(strings.Repeat("a", 2*maxErrorBody)))
+			},
+			Err: "POST {url}: 502 Bad Gateway: " + strings.Repeat("a", maxErrorBody) + "...",
+		},
+		{
+			Name: "ResponseErrors",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"errors": [{"message": "introspection is disabled"}]}`))
+			},
+			Err: "response error: introspection is disabled",
+		},
+		{
+			Name: "Timeout",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				ioutil.ReadAll(r.Body) // lets the server notice the client is gone
+				select {
+				case <-r.Context().Done():
+				case <-time.After(time.Second):
+				}
+			},
+			Opts: &FetchOptions{Timeout: 10 * time.Millisecond},
+			Err:  "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			srv := httptest.NewServer(testCase.Handler)
+			defer srv.Close()
+
+			endpoint := srv.URL + "/graphql?key=value"
+			intro, err := Fetch(context.Background(), endpoint, testCase.Opts)
+			if testCase.Err != "" {
+				if err == nil {
+					subT.Fatal("expected error")
+				}
+				if msg := strings.Replace(testCase.Err, "{url}", endpoint, 1); !strings.Contains(err.Error(), msg) {
+					subT.Fatalf("expected error: %s, got: %s", msg, err)
+				}
+				return
+			}
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			if intro.Schema == nil || len(withoutPrelude(intro).Types) != len(doc.Types) {
+				subT.Errorf("unexpected schema: %v", intro)
+			}
+		})
+	}
+
+	t.Run("StatusError", func(subT *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer srv.Close()
+
+		_, err := Fetch(context.Background(), srv.URL, nil)
+		serr, ok := err.(*StatusError)
+		if !ok {
+			subT.Fatalf("expected *StatusError, got: %v", err)
+		}
+		if serr.StatusCode != http.StatusNotFound || serr.Body != "" {
+			subT.Errorf("unexpected error: %#v", serr)
+		}
+	})
+}
+
+func TestFetchDocSet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		w.Write([]byte(`{"data": {"__schema": {"queryType": {"name": "Query"}, "types": [
+	{"kind": "OBJECT", "name": "Query", "fields": [
+		{"name": "a", "args": [], "type": {"kind": "SCALAR", "name": "Int"}}
+	], "interfaces": []}
+], "directives": []}}}`))
+	}))
+	defer srv.Close()
+
+	dset := token.NewDocSet()
+	doc, err := Fetch(context.Background(), srv.URL, &FetchOptions{DocSet: dset})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pos := dset.Position(token.Pos(doc.Types[0].GetTypeSpec().GetObject().Fields.List[0].Name.NamePos))
+	if pos.String() != srv.URL+":3:3" {
+		t.Errorf("unexpected position: %s", pos)
+	}
+}
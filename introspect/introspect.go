@@ -0,0 +1,376 @@
+// Package introspect relates GraphQL schemas to the results of the
+// introspection query, as served by a GraphQL server.
+//
+package introspect
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/schema"
+	"github.com/gqlc/graphql/token"
+)
+
+// FromDocument returns the result of the standard introspection query for
+// the schema declared by doc i.e. the JSON object {"__schema": {...}}, as
+// a spec-compliant server would return it as the data of its response.
+//
+// The schema is built with schema.New in Prelude mode, so the error of an
+// invalid schema is a validate.ErrorList. Its errors have no positions;
+// use FromSchema to introspect a schema built from a token.DocSet.
+//
+func FromDocument(doc *ast.Document) ([]byte, error) {
+	cfg := &schema.Config{Mode: schema.Prelude}
+	s, err := cfg.New(nil, doc)
+	if err != nil {
+		return nil, err
+	}
+	return FromSchema(s)
+}
+
+// FromSchema returns the result of the standard introspection query for s,
+// like FromDocument. The introspection types are only listed if s was built
+// in Prelude mode.
+//
+// The result includes every field the query may ask for i.e. descriptions,
+// specifiedByURL, isRepeatable and the deprecated fields, enum values,
+// arguments and input fields. Types and directives are sorted by name.
+//
+func FromSchema(s *schema.Schema) ([]byte, error) {
+	r := &result{Schema: &schemaObj{
+		Description:      description(s.Description()),
+		QueryType:        rootType(s.QueryType()),
+		MutationType:     rootType(s.MutationType()),
+		SubscriptionType: rootType(s.SubscriptionType()),
+		Types:            []*typeObj{},
+		Directives:       []*directiveObj{},
+	}}
+	for _, t := range s.Types() {
+		r.Schema.Types = append(r.Schema.Types, fullType(t))
+	}
+	for _, d := range s.DirectiveDefinitions() {
+		r.Schema.Directives = append(r.Schema.Directives, &directiveObj{
+			Name:         d.Name(),
+			Description:  description(d.Description()),
+			IsRepeatable: d.IsRepeatable(),
+			Locations:    locations(d.Locations()),
+			Args:         inputValues(d.Args()),
+		})
+	}
+	return json.Marshal(r)
+}
+
+// The following types mirror the selections of the introspection query.
+// Their fields are in the order the query selects them.
+
+type result struct {
+	Schema *schemaObj `json:"__schema"`
+}
+
+type schemaObj struct {
+	Description      *string         `json:"description"`
+	QueryType        *namedTypeObj   `json:"queryType"`
+	MutationType     *namedTypeObj   `json:"mutationType"`
+	SubscriptionType *namedTypeObj   `json:"subscriptionType"`
+	Types            []*typeObj      `json:"types"`
+	Directives       []*directiveObj `json:"directives"`
+}
+
+type namedTypeObj struct {
+	Name string `json:"name"`
+}
+
+type typeObj struct {
+	Kind           string          `json:"kind"`
+	Name           string          `json:"name"`
+	Description    *string         `json:"description"`
+	SpecifiedByURL *string         `json:"specifiedByURL"`
+	Fields         []*fieldObj     `json:"fields"`
+	InputFields    []*inputObj     `json:"inputFields"`
+	Interfaces     []*typeRefObj   `json:"interfaces"`
+	EnumValues     []*enumValueObj `json:"enumValues"`
+	PossibleTypes  []*typeRefObj   `json:"possibleTypes"`
+}
+
+type fieldObj struct {
+	Name              string      `json:"name"`
+	Description       *string     `json:"description"`
+	Args              []*inputObj `json:"args"`
+	Type              *typeRefObj `json:"type"`
+	IsDeprecated      bool        `json:"isDeprecated"`
+	DeprecationReason *string     `json:"deprecationReason"`
+}
+
+type inputObj struct {
+	Name              string      `json:"name"`
+	Description       *string     `json:"description"`
+	Type              *typeRefObj `json:"type"`
+	DefaultValue      *string     `json:"defaultValue"`
+	IsDeprecated      bool        `json:"isDeprecated"`
+	DeprecationReason *string     `json:"deprecationReason"`
+}
+
+type enumValueObj struct {
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+type directiveObj struct {
+	Name         string      `json:"name"`
+	Description  *string     `json:"description"`
+	IsRepeatable bool        `json:"isRepeatable"`
+	Locations    []string    `json:"locations"`
+	Args         []*inputObj `json:"args"`
+}
+
+type typeRefObj struct {
+	Kind   string      `json:"kind"`
+	Name   *string     `json:"name"`
+	OfType *typeRefObj `json:"ofType"`
+}
+
+func rootType(t *schema.Type) *namedTypeObj {
+	if t == nil {
+		return nil
+	}
+	return &namedTypeObj{Name: t.Name()}
+}
+
+// fullType returns the introspection of t. The fields which don't apply
+// to the kind of t are null, whereas the ones which do are never null.
+//
+func fullType(t *schema.Type) *typeObj {
+	obj := &typeObj{
+		Kind:        t.Kind().String(),
+		Name:        t.Name(),
+		Description: description(t.Description()),
+	}
+
+	switch t.Kind() {
+	case schema.Scalar:
+		if d := directive(t.Directives(), "specifiedBy"); d != nil {
+			obj.SpecifiedByURL = argString(d, "url")
+		}
+	case schema.Object, schema.Interface:
+		obj.Fields = []*fieldObj{}
+		for _, f := range t.Fields() {
+			deprecated, reason := deprecation(f.Directives())
+			obj.Fields = append(obj.Fields, &fieldObj{
+				Name:              f.Name(),
+				Description:       description(f.Description()),
+				Args:              inputValues(f.Args()),
+				Type:              typeRef(f.Type()),
+				IsDeprecated:      deprecated,
+				DeprecationReason: reason,
+			})
+		}
+		obj.Interfaces = typeRefs(t.Interfaces())
+		if t.Kind() == schema.Interface {
+			obj.PossibleTypes = typeRefs(t.PossibleTypes())
+		}
+	case schema.Union:
+		obj.PossibleTypes = typeRefs(t.PossibleTypes())
+	case schema.Enum:
+		obj.EnumValues = []*enumValueObj{}
+		for _, v := range t.EnumValues() {
+			deprecated, reason := deprecation(v.Directives())
+			obj.EnumValues = append(obj.EnumValues, &enumValueObj{
+				Name:              v.Name(),
+				Description:       description(v.Description()),
+				IsDeprecated:      deprecated,
+				DeprecationReason: reason,
+			})
+		}
+	case schema.InputObject:
+		obj.InputFields = inputValues(t.InputFields())
+	}
+	return obj
+}
+
+func inputValues(vals []*schema.InputValue) []*inputObj {
+	objs := []*inputObj{}
+	for _, v := range vals {
+		obj := &inputObj{
+			Name:        v.Name(),
+			Description: description(v.Description()),
+			Type:        typeRef(v.Type()),
+		}
+		if v.DefaultValue() != nil {
+			s := literal(v.DefaultValue())
+			obj.DefaultValue = &s
+		}
+		obj.IsDeprecated, obj.DeprecationReason = deprecation(v.Directives())
+		objs = append(objs, obj)
+	}
+	return objs
+}
+
+func typeRefs(types []*schema.Type) []*typeRefObj {
+	refs := []*typeRefObj{}
+	for _, t := range types {
+		refs = append(refs, typeRef(t))
+	}
+	return refs
+}
+
+func typeRef(ref schema.TypeRef) *typeRefObj {
+	switch t := ref.(type) {
+	case *schema.List:
+		return &typeRefObj{Kind: "LIST", OfType: typeRef(t.OfType())}
+	case *schema.NonNull:
+		return &typeRefObj{Kind: "NON_NULL", OfType: typeRef(t.OfType())}
+	case *schema.Type:
+		name := t.Name()
+		return &typeRefObj{Kind: t.Kind().String(), Name: &name}
+	}
+	return nil
+}
+
+func locations(locs []ast.DirectiveLocation_Loc) []string {
+	names := []string{}
+	for _, l := range locs {
+		names = append(names, l.String())
+	}
+	return names
+}
+
+func description(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// deprecation reports whether @deprecated is among dirs and, if so, the reason
+// given to it or, by default, the default value of its reason argument.
+//
+func deprecation(dirs []*schema.AppliedDirective) (bool, *string) {
+	d := directive(dirs, "deprecated")
+	if d == nil {
+		return false, nil
+	}
+	if d.Arg("reason") == nil && d.Definition() != nil {
+		if arg := d.Definition().Arg("reason"); arg != nil {
+			if lit, ok := arg.DefaultValue().(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				s := schema.StringValue(lit.Value)
+				return true, &s
+			}
+		}
+	}
+	return true, argString(d, "reason")
+}
+
+func directive(dirs []*schema.AppliedDirective, name string) *schema.AppliedDirective {
+	for _, d := range dirs {
+		if d.Name() == name {
+			return d
+		}
+	}
+	return nil
+}
+
+// argString returns the value of the named string argument of d, or nil.
+func argString(d *schema.AppliedDirective, name string) *string {
+	arg := d.Arg(name)
+	if arg == nil {
+		return nil
+	}
+	lit, ok := arg.Value().(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+	s := schema.StringValue(lit.Value)
+	return &s
+}
+
+// literal returns the GraphQL representation of the literal value v,
+// as printed by graphql-js e.g. {a: [1, 2], b: "c"}. Block strings
+// are printed as ordinary strings.
+//
+func literal(v ast.Node) string {
+	var b strings.Builder
+	writeLiteral(&b, v)
+	return b.String()
+}
+
+func writeLiteral(b *strings.Builder, v interface{}) {
+	switch x := v.(type) {
+	case *ast.BasicLit:
+		if x.Kind == token.STRING {
+			writeString(b, schema.StringValue(x.Value))
+			return
+		}
+		b.WriteString(x.Value)
+	case *ast.CompositeLit:
+		switch y := x.Value.(type) {
+		case *ast.CompositeLit_BasicLit:
+			writeLiteral(b, y.BasicLit)
+		case *ast.CompositeLit_ListLit:
+			b.WriteByte('[')
+			switch l := y.ListLit.List.(type) {
+			case *ast.ListLit_BasicList:
+				for i, e := range l.BasicList.Values {
+					if i > 0 {
+						b.WriteString(", ")
+					}
+					writeLiteral(b, e)
+				}
+			case *ast.ListLit_CompositeList:
+				for i, e := range l.CompositeList.Values {
+					if i > 0 {
+						b.WriteString(", ")
+					}
+					writeLiteral(b, e)
+				}
+			}
+			b.WriteByte(']')
+		case *ast.CompositeLit_ObjLit:
+			b.WriteByte('{')
+			for i, f := range y.ObjLit.Fields {
+				if i > 0 {
+					b.WriteString(", ")
+				}
+				b.WriteString(f.Key.Name + ": ")
+				writeLiteral(b, f.Val)
+			}
+			b.WriteByte('}')
+		}
+	}
+}
+
+// writeString writes s as a quoted GraphQL string.
+func writeString(b *strings.Builder, s string) {
+	const hex = "0123456789ABCDEF"
+
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || 0x7f <= r && r < 0xa0 {
+				b.WriteString(`\u00`)
+				b.WriteByte(hex[r>>4])
+				b.WriteByte(hex[r&0xf])
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+}
@@ -0,0 +1,232 @@
+package introspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/prelude"
+	"github.com/gqlc/graphql/printer"
+	"github.com/gqlc/graphql/token"
+	"github.com/gqlc/graphql/validate"
+)
+
+const testSrc = `"The schema."
+schema { query: Query }
+
+"The root type."
+type Query implements Node {
+	id: ID!
+	"Searches for things."
+	search(term: String! = """
+	  a "block"
+	""", filter: Filter = {kinds: [A, B], limit: 10}): [Result!]
+	old: Int @deprecated
+	older: Int @deprecated(reason: "Use \"id\".")
+}
+
+interface Node { id: ID! }
+
+union Result = Query
+
+enum Kind { A, B @deprecated(reason: "No B.") }
+
+input Filter { kinds: [Kind!], limit: Int = 5 @deprecated }
+
+scalar Time @specifiedBy(url: "https://example.com/time")
+
+"""
+"on" or "off".
+  Indented.
+"""
+scalar Switch
+
+directive @tag(name: String!) repeatable on FIELD_DEFINITION | ENUM_VALUE
+`
+
+func TestFromDocument(t *testing.T) {
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(testSrc), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := FromDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var res struct {
+		Schema struct {
+			Description *string
+			QueryType   struct{ Name string }
+			Types       []json.RawMessage
+			Directives  []json.RawMessage
+		} `json:"__schema"`
+	}
+	if err = json.Unmarshal(b, &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Schema.Description == nil || *res.Schema.Description != "The schema." || res.Schema.QueryType.Name != "Query" {
+		t.Errorf("unexpected schema: %s", b[:100])
+	}
+
+	types := make(map[string]string)
+	for _, raw := range res.Schema.Types {
+		var named struct{ Name string }
+		if err = json.Unmarshal(raw, &named); err != nil {
+			t.Fatal(err)
+		}
+		types[named.Name] = string(raw)
+	}
+	directives := make(map[string]string)
+	for _, raw := range res.Schema.Directives {
+		var named struct{ Name string }
+		if err = json.Unmarshal(raw, &named); err != nil {
+			t.Fatal(err)
+		}
+		directives[named.Name] = string(raw)
+	}
+
+	testCases := []struct {
+		Name string
+		JSON string
+		Out  string
+	}{
+		{
+			Name: "Object",
+			JSON: types["Query"],
+			Out:  `{"kind":"OBJECT","name":"Query","description":"The root type.","specifiedByURL":null,"fields":[{"name":"id","description":null,"args":[],"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"ID","ofType":null}},"isDeprecated":false,"deprecationReason":null},{"name":"search","description":"Searches for things.","args":[{"name":"term","description":null,"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String","ofType":null}},"defaultValue":"\"a \\\"block\\\"\"","isDeprecated":false,"deprecationReason":null},{"name":"filter","description":null,"type":{"kind":"INPUT_OBJECT","name":"Filter","ofType":null},"defaultValue":"{kinds: [A, B], limit: 10}","isDeprecated":false,"deprecationReason":null}],"type":{"kind":"LIST","name":null,"ofType":{"kind":"NON_NULL","name":null,"ofType":{"kind":"UNION","name":"Result","ofType":null}}},"isDeprecated":false,"deprecationReason":null},{"name":"old","description":null,"args":[],"type":{"kind":"SCALAR","name":"Int","ofType":null},"isDeprecated":true,"deprecationReason":"No longer supported"},{"name":"older","description":null,"args":[],"type":{"kind":"SCALAR","name":"Int","ofType":null},"isDeprecated":true,"deprecationReason":"Use \"id\"."}],"inputFields":null,"interfaces":[{"kind":"INTERFACE","name":"Node","ofType":null}],"enumValues":null,"possibleTypes":null}`,
+		},
+		{
+			Name: "Interface",
+			JSON: types["Node"],
+			Out:  `{"kind":"INTERFACE","name":"Node","description":null,"specifiedByURL":null,"fields":[{"name":"id","description":null,"args":[],"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"ID","ofType":null}},"isDeprecated":false,"deprecationReason":null}],"inputFields":null,"interfaces":[],"enumValues":null,"possibleTypes":[{"kind":"OBJECT","name":"Query","ofType":null}]}`,
+		},
+		{
+			Name: "Union",
+			JSON: types["Result"],
+			Out:  `{"kind":"UNION","name":"Result","description":null,"specifiedByURL":null,"fields":null,"inputFields":null,"interfaces":null,"enumValues":null,"possibleTypes":[{"kind":"OBJECT","name":"Query","ofType":null}]}`,
+		},
+		{
+			Name: "Enum",
+			JSON: types["Kind"],
+			Out:  `{"kind":"ENUM","name":"Kind","description":null,"specifiedByURL":null,"fields":null,"inputFields":null,"interfaces":null,"enumValues":[{"name":"A","description":null,"isDeprecated":false,"deprecationReason":null},{"name":"B","description":null,"isDeprecated":true,"deprecationReason":"No B."}],"possibleTypes":null}`,
+		},
+		{
+			Name: "InputObject",
+			JSON: types["Filter"],
+			Out:  `{"kind":"INPUT_OBJECT","name":"Filter","description":null,"specifiedByURL":null,"fields":null,"inputFields":[{"name":"kinds","description":null,"type":{"kind":"LIST","name":null,"ofType":{"kind":"NON_NULL","name":null,"ofType":{"kind":"ENUM","name":"Kind","ofType":null}}},"defaultValue":null,"isDeprecated":false,"deprecationReason":null},{"name":"limit","description":null,"type":{"kind":"SCALAR","name":"Int","ofType":null},"defaultValue":"5","isDeprecated":true,"deprecationReason":"No longer supported"}],"interfaces":null,"enumValues":null,"possibleTypes":null}`,
+		},
+		{
+			Name: "Scalar",
+			JSON: types["Time"],
+			Out:  `{"kind":"SCALAR","name":"Time","description":null,"specifiedByURL":"https://example.com/time","fields":null,"inputFields":null,"interfaces":null,"enumValues":null,"possibleTypes":null}`,
+		},
+		{
+			Name: "Directive",
+			JSON: directives["tag"],
+			Out:  `{"name":"tag","description":null,"isRepeatable":true,"locations":["FIELD_DEFINITION","ENUM_VALUE"],"args":[{"name":"name","description":null,"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String","ofType":null}},"defaultValue":null,"isDeprecated":false,"deprecationReason":null}]}`,
+		},
+		{
+			Name: "BuiltinDirective",
+			JSON: directives["skip"],
+			Out:  `{"name":"skip","description":"Directs the executor to skip this field or fragment when the ` + "`if`" + ` argument is true.","isRepeatable":false,"locations":["FIELD","FRAGMENT_SPREAD","INLINE_FRAGMENT"],"args":[{"name":"if","description":"Skipped when true.","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"Boolean","ofType":null}},"defaultValue":null,"isDeprecated":false,"deprecationReason":null}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			if testCase.JSON != testCase.Out {
+				subT.Errorf("expected:\n%s\ngot:\n%s", testCase.Out, testCase.JSON)
+			}
+		})
+	}
+
+	t.Run("Builtins", func(subT *testing.T) {
+		for _, name := range []string{"Boolean", "Float", "ID", "Int", "String", "__Schema", "__Type", "__TypeKind", "__Field", "__InputValue", "__EnumValue", "__Directive", "__DirectiveLocation"} {
+			if _, ok := types[name]; !ok {
+				subT.Errorf("expected built-in type %s", name)
+			}
+		}
+		for _, name := range []string{"deprecated", "include", "skip", "specifiedBy"} {
+			if _, ok := directives[name]; !ok {
+				subT.Errorf("expected built-in directive @%s", name)
+			}
+		}
+	})
+}
+
+func TestFromDocumentRoundTrip(t *testing.T) {
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(testSrc), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := FromDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	intro, err := parser.ParseIntrospection(token.NewDocSet(), "test.json", strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := FromDocument(withoutPrelude(intro))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(b) {
+		t.Errorf("expected:\n%s\ngot:\n%s", b, out)
+	}
+
+	// the results can be printed as SDL, too
+	var sdl bytes.Buffer
+	if err = printer.Fprint(&sdl, nil, intro); err != nil {
+		t.Fatal(err)
+	}
+	doc, err = parser.ParseDoc(token.NewDocSet(), "test", &sdl, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, err = FromDocument(doc); err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(b) {
+		t.Errorf("expected:\n%s\ngot:\n%s", b, out)
+	}
+}
+
+func TestFromDocumentInvalid(t *testing.T) {
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(`type Query { a: Undefined }`), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = FromDocument(doc)
+	if _, ok := err.(validate.ErrorList); !ok {
+		t.Fatalf("expected validate.ErrorList, got: %v", err)
+	}
+}
+
+// withoutPrelude removes the declarations of the prelude from the
+// result of ParseIntrospection, since FromDocument supplies them.
+//
+func withoutPrelude(doc *ast.Document) *ast.Document {
+	types := doc.Types[:0]
+	for _, td := range doc.Types {
+		ts := td.GetTypeSpec()
+		builtin := prelude.IsBuiltin(ts.GetName().GetName())
+		if ts.GetDirective() != nil {
+			builtin = prelude.IsBuiltinDirective(ts.Name.Name)
+		}
+		if !builtin {
+			types = append(types, td)
+		}
+	}
+	doc.Types = types
+	return doc
+}
@@ -0,0 +1,145 @@
+package introspect
+
+import "strings"
+
+// QueryOptions select the features of the introspection query which were added
+// to the spec over time, so older servers may not support them. The zero value
+// selects none of them.
+//
+type QueryOptions struct {
+	Descriptions          bool // descriptions of types, fields, arguments, etc.
+	SchemaDescription     bool // description of the schema itself
+	SpecifiedByURL        bool // specifiedByURL of scalars
+	DirectiveIsRepeatable bool // isRepeatable of directives
+	InputValueDeprecation bool // deprecated arguments and input fields
+}
+
+// typeRefDepth is how many ofType levels the TypeRef fragment selects, which
+// is enough for e.g. [[[String!]!]!]!.
+//
+const typeRefDepth = 8
+
+// Query returns the standard introspection query, as sent by GraphQL clients,
+// with the features selected by opts. Its result can be read by ParseIntrospection
+// of package parser, while FromDocument returns its result with every feature.
+//
+// The interfaces of every type are selected, so servers which support interfaces
+// implementing interfaces return those, too. Older servers return null for them.
+//
+func Query(opts QueryOptions) string {
+	q := new(query)
+	q.line("query IntrospectionQuery {")
+	q.line("__schema {")
+	q.lineIf(opts.SchemaDescription, "description")
+	q.line("queryType { name }")
+	q.line("mutationType { name }")
+	q.line("subscriptionType { name }")
+	q.line("types {")
+	q.line("...FullType")
+	q.line("}")
+	q.line("directives {")
+	q.line("name")
+	q.lineIf(opts.Descriptions, "description")
+	q.lineIf(opts.DirectiveIsRepeatable, "isRepeatable")
+	q.line("locations")
+	q.line("args" + includeDeprecated(opts) + " {")
+	q.line("...InputValue")
+	q.line("}")
+	q.line("}")
+	q.line("}")
+	q.line("}")
+
+	q.line("")
+	q.line("fragment FullType on __Type {")
+	q.line("kind")
+	q.line("name")
+	q.lineIf(opts.Descriptions, "description")
+	q.lineIf(opts.SpecifiedByURL, "specifiedByURL")
+	q.line("fields(includeDeprecated: true) {")
+	q.line("name")
+	q.lineIf(opts.Descriptions, "description")
+	q.line("args" + includeDeprecated(opts) + " {")
+	q.line("...InputValue")
+	q.line("}")
+	q.line("type {")
+	q.line("...TypeRef")
+	q.line("}")
+	q.line("isDeprecated")
+	q.line("deprecationReason")
+	q.line("}")
+	q.line("inputFields" + includeDeprecated(opts) + " {")
+	q.line("...InputValue")
+	q.line("}")
+	q.line("interfaces {")
+	q.line("...TypeRef")
+	q.line("}")
+	q.line("enumValues(includeDeprecated: true) {")
+	q.line("name")
+	q.lineIf(opts.Descriptions, "description")
+	q.line("isDeprecated")
+	q.line("deprecationReason")
+	q.line("}")
+	q.line("possibleTypes {")
+	q.line("...TypeRef")
+	q.line("}")
+	q.line("}")
+
+	q.line("")
+	q.line("fragment InputValue on __InputValue {")
+	q.line("name")
+	q.lineIf(opts.Descriptions, "description")
+	q.line("type { ...TypeRef }")
+	q.line("defaultValue")
+	q.lineIf(opts.InputValueDeprecation, "isDeprecated")
+	q.lineIf(opts.InputValueDeprecation, "deprecationReason")
+	q.line("}")
+
+	q.line("")
+	q.line("fragment TypeRef on __Type {")
+	q.line("kind")
+	q.line("name")
+	for i := 0; i < typeRefDepth; i++ {
+		q.line("ofType {")
+		q.line("kind")
+		q.line("name")
+	}
+	for i := 0; i < typeRefDepth; i++ {
+		q.line("}")
+	}
+	q.line("}")
+	return q.String()
+}
+
+// query writes the lines of a query, indenting them by their nesting depth.
+type query struct {
+	strings.Builder
+	depth int
+}
+
+func (q *query) line(s string) {
+	if strings.HasPrefix(s, "}") {
+		q.depth--
+	}
+	if s != "" {
+		q.WriteString(strings.Repeat("  ", q.depth))
+		q.WriteString(s)
+	}
+	q.WriteByte('\n')
+	if strings.HasSuffix(s, "{") {
+		q.depth++
+	}
+}
+
+func (q *query) lineIf(ok bool, s string) {
+	if ok {
+		q.line(s)
+	}
+}
+
+// includeDeprecated returns the arguments selecting deprecated input values, if supported.
+func includeDeprecated(opts QueryOptions) string {
+	if !opts.InputValueDeprecation {
+		return ""
+	}
+	return "(includeDeprecated: true)"
+}
@@ -0,0 +1,145 @@
+package introspect
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/token"
+)
+
+func TestQuery(t *testing.T) {
+	doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(testSrc), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	full, err := FromDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all := QueryOptions{
+		Descriptions:          true,
+		SchemaDescription:     true,
+		SpecifiedByURL:        true,
+		DirectiveIsRepeatable: true,
+		InputValueDeprecation: true,
+	}
+
+	testCases := []struct {
+		Name string
+		Opts QueryOptions
+	}{
+		{Name: "None"},
+		{Name: "Descriptions", Opts: QueryOptions{Descriptions: true}},
+		{Name: "SchemaDescription", Opts: QueryOptions{SchemaDescription: true}},
+		{Name: "SpecifiedByURL", Opts: QueryOptions{SpecifiedByURL: true}},
+		{Name: "DirectiveIsRepeatable", Opts: QueryOptions{DirectiveIsRepeatable: true}},
+		{Name: "InputValueDeprecation", Opts: QueryOptions{InputValueDeprecation: true}},
+		{Name: "All", Opts: all},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			q, err := parser.ParseQuery(token.NewDocSet(), "query", strings.NewReader(Query(testCase.Opts)), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			// answer the query with the results of the full query,
+			// then read those back, as they would be fetched
+			var data interface{}
+			if err = json.Unmarshal(full, &data); err != nil {
+				subT.Fatal(err)
+			}
+			b, err := json.Marshal(answer(subT, q, data))
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			intro, err := parser.ParseIntrospection(token.NewDocSet(), "test.json", strings.NewReader(string(b)))
+			if err != nil {
+				subT.Fatal(err)
+			}
+			out, err := FromDocument(withoutPrelude(intro))
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			opts := testCase.Opts
+			features := []struct {
+				name     string
+				selected bool
+				json     string
+			}{
+				{"descriptions", opts.Descriptions, `"description":"The root type."`},
+				{"schema description", opts.SchemaDescription, `"description":"The schema."`},
+				{"specifiedByURL", opts.SpecifiedByURL, `"specifiedByURL":"https://example.com/time"`},
+				{"isRepeatable", opts.DirectiveIsRepeatable, `"name":"tag","description":null,"isRepeatable":true`},
+				{"input value deprecation", opts.InputValueDeprecation, `"defaultValue":"5","isDeprecated":true`},
+			}
+			for _, f := range features {
+				if strings.Contains(string(out), f.json) != f.selected {
+					subT.Errorf("expected %s to be selected: %v", f.name, f.selected)
+				}
+			}
+			if opts == all && string(out) != string(full) {
+				subT.Errorf("expected:\n%s\ngot:\n%s", full, out)
+			}
+		})
+	}
+}
+
+// answer returns the part of the query's result, data, which q selects.
+// Every selected field must be in data.
+//
+func answer(t *testing.T, q *ast.ExecutableDocument, data interface{}) interface{} {
+	frags := make(map[string]*ast.SelectionSet)
+	var op *ast.SelectionSet
+	for _, def := range q.Definitions {
+		if f := def.GetFragment(); f != nil {
+			frags[f.Name.Name] = f.SelectionSet
+			continue
+		}
+		op = def.GetOperation().SelectionSet
+	}
+
+	var selectSet func(set *ast.SelectionSet, v interface{}) interface{}
+	selectSet = func(set *ast.SelectionSet, v interface{}) interface{} {
+		switch x := v.(type) {
+		case []interface{}:
+			l := make([]interface{}, len(x))
+			for i, e := range x {
+				l[i] = selectSet(set, e)
+			}
+			return l
+		case map[string]interface{}:
+			obj := make(map[string]interface{})
+			var selectFields func(set *ast.SelectionSet)
+			selectFields = func(set *ast.SelectionSet) {
+				for _, sel := range set.List {
+					if spread := sel.GetFragmentSpread(); spread != nil {
+						selectFields(frags[spread.Name.Name])
+						continue
+					}
+
+					f := sel.GetField()
+					val, ok := x[f.Name.Name]
+					if !ok {
+						t.Fatalf("unknown field: %s", f.Name.Name)
+					}
+					if f.SelectionSet != nil {
+						val = selectSet(f.SelectionSet, val)
+					}
+					obj[f.Name.Name] = val
+				}
+			}
+			selectFields(set)
+			return obj
+		}
+		return v
+	}
+	return selectSet(op, data)
+}
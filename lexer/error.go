@@ -0,0 +1,55 @@
+package lexer
+
+import (
+	"strconv"
+
+	"github.com/gqlc/graphql/token"
+)
+
+// ErrorCode classifies a lexical error. Codes are stable, new codes
+// are only ever appended.
+//
+type ErrorCode int
+
+// Lexical error codes
+const (
+	ErrUnknown        ErrorCode = iota // unclassified error
+	ErrUnexpectedChar                  // character is not valid in the current context
+	ErrBadString                       // unterminated or malformed string
+	ErrBadNumber                       // malformed int or float
+	ErrBadSpread                       // incomplete spread operator
+)
+
+var errorCodes = [...]string{
+	ErrUnknown:        "Unknown",
+	ErrUnexpectedChar: "UnexpectedChar",
+	ErrBadString:      "BadString",
+	ErrBadNumber:      "BadNumber",
+	ErrBadSpread:      "BadSpread",
+}
+
+func (c ErrorCode) String() string {
+	if 0 <= c && int(c) < len(errorCodes) {
+		return errorCodes[c]
+	}
+	return "ErrorCode(" + strconv.Itoa(int(c)) + ")"
+}
+
+// Error represents a lexical error. It is attached to
+// the token.ERR Item emitted by the lexer.
+//
+type Error struct {
+	Pos      token.Pos      // position of the offending src
+	Position token.Position // full position of the offending src
+	Code     ErrorCode
+	Found    string // offending src; or empty
+	Msg      string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Position.Filename != "" || e.Position.IsValid() {
+		return e.Position.String() + ": " + e.Msg
+	}
+	return e.Msg
+}
@@ -16,6 +16,8 @@ type Item struct {
 	Line int
 	Typ  token.Token
 	Val  string
+
+	Err *Error `json:",omitempty"` // set for token.ERR items
 }
 
 func (i Item) String() string {
@@ -43,9 +45,10 @@ type Interface interface {
 
 type lxr struct {
 	// immutable state
-	doc  *token.Doc
-	name string
-	src  string
+	doc          *token.Doc
+	name         string
+	src          string
+	descriptions bool // strings outside of values are descriptions
 
 	// scanning state
 	pos   int
@@ -53,16 +56,42 @@ type lxr struct {
 	width int
 	line  int
 	items chan Item
+
+	// string context
+	prev     token.Token   // previous significant token
+	brackets []token.Token // currently open brackets, braces and parentheses
+
+	// recovery state
+	sync stateFn // state to resume from after an error; or nil
 }
 
 // Lex lexs the given src based on the the GraphQL IDL specification.
 func Lex(doc *token.Doc, src string) Interface {
+	l := &lxr{
+		doc:          doc,
+		name:         doc.Name(),
+		src:          src,
+		descriptions: true,
+		items:        make(chan Item, 2),
+		line:         1,
+		sync:         syncDoc,
+	}
+
+	go l.run()
+	return l
+}
+
+// LexQuery lexs the given src as a GraphQL executable document
+// i.e. operations and fragments.
+//
+func LexQuery(doc *token.Doc, src string) Interface {
 	l := &lxr{
 		doc:   doc,
 		name:  doc.Name(),
 		src:   src,
 		items: make(chan Item, 2),
 		line:  1,
+		sync:  syncQuery,
 	}
 
 	go l.run()
@@ -83,7 +112,7 @@ func (l *lxr) run() {
 		l.backup()
 	}
 
-	for state := lexDoc; state != nil; {
+	for state := stateFn(lexDoc); state != nil; {
 		state = state(l)
 	}
 	close(l.items)
@@ -127,8 +156,20 @@ func (l *lxr) backup() {
 // TODO: Check emitted value for newline characters and subtract them from l.line
 // emit passes an item back to the client.
 func (l *lxr) emit(t token.Token) {
-	l.items <- Item{l.doc.Pos(l.start), l.line, t, l.src[l.start:l.pos]}
+	l.items <- Item{Pos: l.doc.Pos(l.start), Line: l.line, Typ: t, Val: l.src[l.start:l.pos]}
 	l.start = l.pos
+
+	switch t {
+	case token.COMMENT:
+		return
+	case token.LBRACK, token.LBRACE, token.LPAREN:
+		l.brackets = append(l.brackets, t)
+	case token.RBRACK, token.RBRACE, token.RPAREN:
+		if n := len(l.brackets); n > 0 {
+			l.brackets = l.brackets[:n-1]
+		}
+	}
+	l.prev = t
 }
 
 // ignore skips over the pending src before this point.
@@ -152,24 +193,91 @@ func (l *lxr) acceptRun(valid string) {
 	l.backup()
 }
 
-// errorf returns an error token and terminates the scan by passing
-// back a nil pointer that will be the next state, terminating l.nextItem.
-func (l *lxr) errorf(format string, args ...interface{}) stateFn {
-	l.items <- Item{l.doc.Pos(l.start), l.line, token.ERR, fmt.Sprintf(format, args...)}
-	return nil
+// errorf returns an error token and continues the scan from the start
+// of the next top-level definition. If the lexer has no recovery state,
+// it terminates the scan by passing back a nil pointer that will be the
+// next state, terminating l.nextItem.
+//
+func (l *lxr) errorf(code ErrorCode, format string, args ...interface{}) stateFn {
+	err := &Error{
+		Pos:      l.doc.Pos(l.start),
+		Position: l.doc.Position(l.doc.Pos(l.start)),
+		Code:     code,
+		Found:    l.src[l.start:l.pos],
+		Msg:      fmt.Sprintf(format, args...),
+	}
+	l.items <- Item{Pos: err.Pos, Line: l.line, Typ: token.ERR, Val: err.Msg, Err: err}
+	return l.sync
 }
 
-// ignoreWhiteSpace consume all whitespace
-func (l *lxr) ignoreWhiteSpace() {
-	for r := l.next(); r == ' ' || r == '\t' || r == '\r' || r == '\n'; r = l.next() {
+// skipToLine skips the src until the start of a line, ignoring indentation,
+// for which begins reports true. It reports false if eof was reached instead.
+//
+func (l *lxr) skipToLine(begins func(rest string) bool) bool {
+	l.prev, l.brackets = token.UNKNOWN, l.brackets[:0]
+
+	atLineStart := l.pos > 0 && l.src[l.pos-1] == '\n'
+	for {
+		if atLineStart {
+			l.ignoreWhiteSpace()
+			if begins(l.src[l.pos:]) {
+				return true
+			}
+		}
+
+		switch r := l.next(); r {
+		case eof:
+			l.ignore()
+			return false
+		case '\n':
+			atLineStart = true
+		default:
+			atLineStart = false
+		}
 	}
-	l.backup()
-	l.ignore()
 }
 
-// ignoreSpace consumes all ' ' and '\t'
-func (l *lxr) ignoreSpace() {
-	for r := l.next(); r == ' ' || r == '\t'; r = l.next() {
+// hasKeywordPrefix reports whether s begins with one of the given keywords.
+func hasKeywordPrefix(s string, keywords ...string) bool {
+	for _, kw := range keywords {
+		if !strings.HasPrefix(s, kw) {
+			continue
+		}
+		if len(s) == len(kw) {
+			return true
+		}
+
+		r, _ := utf8.DecodeRuneInString(s[len(kw):])
+		if !isAlphaNumeric(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// syncDoc resumes lexing after an error at the next line
+// which begins a type system definition.
+//
+func syncDoc(l *lxr) stateFn {
+	l.skipToLine(func(rest string) bool {
+		return hasKeywordPrefix(rest, "schema", "scalar", "type", "interface", "union", "enum", "input", "directive", "extend")
+	})
+	return lexDoc
+}
+
+// syncQuery resumes lexing after an error at the next line
+// which begins an operation or fragment definition.
+//
+func syncQuery(l *lxr) stateFn {
+	l.skipToLine(func(rest string) bool {
+		return strings.HasPrefix(rest, "{") || hasKeywordPrefix(rest, "query", "mutation", "subscription", "fragment")
+	})
+	return lexDoc
+}
+
+// ignoreWhiteSpace consume all whitespace
+func (l *lxr) ignoreWhiteSpace() {
+	for r := l.next(); r == ' ' || r == '\t' || r == '\r' || r == '\n'; r = l.next() {
 	}
 	l.backup()
 	l.ignore()
@@ -188,18 +296,34 @@ func (l *lxr) Drain() {
 	}
 }
 
-const spaceChars = " \t\r\n"
+// punctuators maps the single character punctuators to their tokens.
+var punctuators = map[rune]token.Token{
+	'!': token.NOT,
+	'$': token.VAR,
+	'&': token.AND,
+	'(': token.LPAREN,
+	')': token.RPAREN,
+	':': token.COLON,
+	'=': token.ASSIGN,
+	'@': token.AT,
+	'[': token.LBRACK,
+	']': token.RBRACK,
+	'{': token.LBRACE,
+	'|': token.OR,
+	'}': token.RBRACE,
+}
 
+// lexDoc lexes a GraphQL document. Line terminators, like all other
+// whitespace and commas, are insignificant so every token is scanned
+// the same way no matter where it appears.
+//
 func lexDoc(l *lxr) stateFn {
 	switch r := l.next(); {
 	case r == eof:
-		if l.pos > l.start {
-			return l.errorf("unexpected eof")
-		}
 		l.emit(token.EOF)
 		return nil
-	case r == ' ', r == '\t', r == '\r', r == '\n':
-		l.ignoreWhiteSpace()
+	case isSpace(r), r == ',':
+		l.ignore()
 	case r == '#':
 		for s := l.next(); s != '\r' && s != '\n' && s != eof; {
 			s = l.next()
@@ -207,690 +331,85 @@ func lexDoc(l *lxr) stateFn {
 		l.emit(token.COMMENT)
 	case r == '"':
 		l.backup()
-		if !l.scanString() {
-			return l.errorf("bad string syntax: %s", l.src[l.start:l.pos])
-		}
-		l.emit(token.DESCRIPTION)
-	case r == '@':
-		l.backup()
-		return l.scanDirectives(lexDoc)
-	case isAlphaNumeric(r) && !unicode.IsDigit(r):
-		l.backup()
-		return lexDef
-	}
-
-	return lexDoc
-}
-
-func (l *lxr) scanDirectives(parent stateFn) stateFn {
-	for {
-		r := l.next()
-		if r != '@' {
-			l.backup()
-			return parent
-		}
-		l.emit(token.AT)
-
-		name := l.scanIdentifier()
-		if name == token.ERR {
-			return l.errorf("unexpected token")
-		}
-		l.emit(name)
-
-		l.ignoreSpace()
-
-		r = l.peek()
-		switch r {
-		case eof:
-			return parent
-		case '(':
-			ok := l.scanArgs()
-			if !ok {
-				return l.errorf("invalid arg")
-			}
-
-			l.ignoreSpace()
-			r = l.peek()
-		}
-
-		if r == ',' {
-			l.next()
+		if !l.scanStringLit() {
+			return l.errorf(ErrBadString, "bad string syntax: %s", l.src[l.start:l.pos])
 		}
-		l.ignoreSpace()
-	}
-}
-
-func (l *lxr) scanArgs() bool {
-	if !l.accept("(") {
-		return false
-	}
-	l.emit(token.LPAREN)
-
-	l.ignoreWhiteSpace()
-
-	for {
-		r := l.next()
-		if r == ')' {
-			l.emit(token.RPAREN)
-			return true
+		l.emit(l.stringToken())
+	case r == '.':
+		if !l.accept(".") || !l.accept(".") {
+			return l.errorf(ErrBadSpread, "expected spread operator: %s", l.src[l.start:l.pos])
 		}
-
+		l.emit(token.PERIOD)
+	case r == '-' || unicode.IsDigit(r):
 		l.backup()
-		if r == eof {
-			return false
-		}
-
-		name := l.scanIdentifier()
-		if name == token.ERR {
-			return false
-		}
-		l.emit(name)
-
-		l.ignoreSpace()
-
-		if !l.accept(":") {
-			return false
-		}
-		l.emit(token.COLON)
-
-		l.ignoreSpace()
-
-		ok := l.scanValue()
-		if !ok {
-			return false
-		}
-
-		l.acceptRun(spaceChars)
-		if l.peek() == ',' {
-			l.next()
-			l.acceptRun(spaceChars)
-		}
-		l.ignore()
-	}
-}
-
-// scanValue scans a Value
-func (l *lxr) scanValue() (ok bool) {
-	emitter := func() {}
-
-	switch r := l.peek(); {
-	case r == '"':
-		ok = l.scanString()
-		if !ok {
-			emitter = func() { l.errorf("") }
-			break
-		}
-		emitter = func() { l.emit(token.STRING) }
-	case isAlphaNumeric(r):
-		if unicode.IsDigit(r) {
-			num := l.scanNumber()
-			if num == token.ERR {
-				emitter = func() { l.errorf("") }
-				break
-			}
-			emitter = func() { l.emit(num) }
-			ok = true
-			break
-		}
-		tok := l.scanIdentifier()
-		if tok == token.ERR {
-			emitter = func() { l.errorf("") }
-			break
-		}
-		emitter = func() { l.emit(tok) }
-		ok = true
-	case r == '-':
 		num := l.scanNumber()
-		if num == token.ERR {
-			emitter = func() { l.errorf("") }
-			break
-		}
-		emitter = func() { l.emit(num) }
-		ok = true
-	case r == '[':
-		ok = l.scanListLit()
-		if ok {
-			emitter = func() { l.emit(token.RBRACK) }
+		if r := l.peek(); r == '.' || isAlphaNumeric(r) {
+			return l.errorf(ErrBadNumber, "malformed number: %s", l.src[l.start:l.pos+1])
 		}
-	case r == '{':
-		ok = l.scanObjLit()
-		if ok {
-			emitter = func() { l.emit(token.RBRACE) }
-		}
-	}
-
-	emitter()
-
-	return
-}
-
-func (l *lxr) scanListLit() bool {
-	l.accept("[")
-	l.emit(token.LBRACK)
-
-	l.ignoreWhiteSpace()
-
-	for {
-		r := l.next()
-		if r == ']' {
-			return true
+		l.emit(num)
+	case isAlphaNumeric(r):
+		for r = l.next(); isAlphaNumeric(r); {
+			r = l.next()
 		}
-
 		l.backup()
-		if r == eof {
-			return false
-		}
-
-		ok := l.scanValue()
+		l.emit(token.Lookup(l.src[l.start:l.pos]))
+	default:
+		tok, ok := punctuators[r]
 		if !ok {
-			return false
-		}
-
-		l.acceptRun(spaceChars)
-		if l.peek() == ',' {
-			l.next()
-			l.acceptRun(spaceChars)
+			return l.errorf(ErrUnexpectedChar, "unexpected character: %s", string(r))
 		}
-		l.ignore()
+		l.emit(tok)
 	}
-}
-
-func (l *lxr) scanObjLit() bool {
-	l.accept("{")
-	l.emit(token.LBRACE)
-
-	l.ignoreWhiteSpace()
-
-	for {
-		r := l.next()
-		if r == '}' {
-			return true
-		}
-
-		l.backup()
-		if r == eof {
-			return false
-		}
-
-		key := l.scanIdentifier()
-		if key == token.ERR {
-			return false
-		}
-		l.emit(key)
-
-		l.ignoreSpace()
-
-		if !l.accept(":") {
-			return false
-		}
-		l.emit(token.COLON)
-
-		l.ignoreSpace()
 
-		ok := l.scanValue()
-		if !ok {
-			return false
-		}
-
-		l.acceptRun(spaceChars)
-		if l.peek() == ',' {
-			l.next()
-			l.acceptRun(spaceChars)
-		}
-		l.ignore()
-	}
+	return lexDoc
 }
 
-func lexDef(l *lxr) stateFn {
-	// Ident, Ident, (Ident), (Implements/Directives/InputArguments), (on={), (Members/FieldList/InputArguments)
-	declIdent := l.scanIdentifier()
-	if !declIdent.IsKeyword() {
-		return l.errorf("invalid type declaration")
-	}
-	l.emit(declIdent)
-
-	l.ignoreSpace()
-
-	if declIdent == token.EXTEND {
-		if l.accept("@") {
-			l.emit(token.AT)
-		}
-
-		declIdent = l.scanIdentifier()
-		if !declIdent.IsKeyword() || declIdent == token.DIRECTIVE {
-			return l.errorf("invalid type extension")
-		}
-		l.emit(declIdent)
-
-		l.ignoreSpace()
+// stringToken returns the token for a string which has just been scanned.
+// In a type system document, any string which is not part of a value is
+// a description.
+//
+func (l *lxr) stringToken() token.Token {
+	if !l.descriptions || l.prev == token.COLON || l.prev == token.ASSIGN {
+		return token.STRING
 	}
-
-	var id token.Token
-	r := l.peek()
-	switch {
-	case r == '@' && declIdent == token.DIRECTIVE:
-		l.next()
-		l.emit(token.AT)
-		id = l.scanIdentifier()
-		if id == token.ERR {
-			return l.errorf("malformed directive name: %s", l.src[l.start:l.pos])
-		}
-		l.emit(id)
-	case isAlphaNumeric(r) && !unicode.IsDigit(r):
-		id = l.scanIdentifier()
-		if id == token.ERR {
-			return l.errorf("malformed type name: %s", l.src[l.start:l.pos])
-		}
-		l.emit(id)
+	if n := len(l.brackets); n > 0 && l.brackets[n-1] == token.LBRACK {
+		return token.STRING
 	}
-
-	l.ignoreSpace()
-
-	return lexDefContents
+	return token.DESCRIPTION
 }
 
-func lexDefContents(l *lxr) stateFn {
-declHead:
-	switch r := l.next(); r {
-	case eof, '\r', '\n':
-		l.backup()
-		return lexDoc
-	case '#': // Comment
-		for r = l.next(); r != '\r' && r != '\n' && r != eof; {
-			r = l.next()
-		}
-		l.emit(token.COMMENT)
-
-		break
-	case '(': // InputArguments
-		l.backup()
-		ok := l.scanArgDefs()
-		if !ok {
-			return lexDoc
-		}
-
-		l.ignoreSpace()
-		goto declHead
-	case 'i': // Implements
-		impls := l.scanIdentifier()
-		if impls != token.IMPLEMENTS {
-			return l.errorf("expected 'implements' token, not: %s", impls)
-		}
-		l.emit(impls)
-
-		l.ignoreWhiteSpace()
+// scanStringLit scans a string or block string, respecting escape sequences.
+func (l *lxr) scanStringLit() bool {
+	l.next()
+	if strings.HasPrefix(l.src[l.pos:], `""`) {
+		l.next()
+		l.next()
 
 		for {
-			r := l.peek()
-			if r == '&' {
+			switch r := l.next(); {
+			case r == eof:
+				return false
+			case r == '\\' && strings.HasPrefix(l.src[l.pos:], `"""`):
+				l.pos += 3
+			case r == '"' && strings.HasPrefix(l.src[l.pos:], `""`):
 				l.next()
-				l.emit(token.AND)
-				l.ignoreSpace()
-			}
-			if r == eof || r == '@' || r == '{' || r == '#' || r == '"' {
-				break
-			}
-
-			loc := l.scanIdentifier()
-			if loc == token.ERR {
-				return l.errorf("malformed interface name")
-			}
-			if loc.IsKeyword() {
-				l.pos = l.start
-				return lexDef
-			}
-			l.emit(token.IDENT)
-
-			l.ignoreWhiteSpace()
-		}
-
-		l.ignoreSpace()
-		goto declHead
-	case 'o': // on
-		on := l.scanIdentifier()
-		if on != token.ON {
-			return l.errorf("expected 'on' token, not: %s", on)
-		}
-		l.emit(token.ON)
-
-		l.ignoreWhiteSpace()
-
-		for {
-			r := l.peek()
-			if r == '|' {
 				l.next()
-				l.emit(token.OR)
-				l.ignoreSpace()
-			}
-			if r == eof || r == '#' || r == '"' {
-				break
-			}
-
-			loc := l.scanIdentifier()
-			if loc == token.ERR {
-				return l.errorf("malformed directive location")
-			}
-			if loc.IsKeyword() {
-				l.pos = l.start
-				return lexDef
+				return true
 			}
-			l.emit(token.IDENT)
-
-			l.ignoreWhiteSpace()
-		}
-	case '@': // directives
-		l.backup()
-
-		return l.scanDirectives(lexDefContents)
-	case '{': // fields
-		l.backup()
-		return lexFieldList
-	case '=': // Union
-		l.emit(token.ASSIGN)
-
-		l.ignoreWhiteSpace()
-
-		for {
-			r := l.next()
-			if r == eof || r == '#' || r == '"' {
-				l.backup()
-				break
-			}
-			if r == '|' {
-				l.emit(token.OR)
-				l.ignoreSpace()
-			}
-
-			loc := l.scanIdentifier()
-			if loc == token.ERR {
-				return l.errorf("malformed union member")
-			}
-			if loc.IsKeyword() {
-				l.pos = l.start
-				return lexDef
-			}
-			l.emit(token.IDENT)
-
-			l.ignoreWhiteSpace()
-		}
-	default:
-		return l.errorf("unexpected character in type decl: %s", string(r))
-	}
-
-	return lexDoc
-}
-
-// lexFieldList lexes a list of fields
-func lexFieldList(l *lxr) stateFn {
-	l.accept("{")
-	l.emit(token.LBRACE)
-
-	l.ignoreWhiteSpace()
-
-	for {
-		r := l.next()
-		switch {
-		case r == eof:
-			l.backup()
-			return lexDoc
-		case r == '}':
-			l.emit(token.RBRACE)
-			return lexDoc
-		case r == '#':
-			for s := l.next(); s != '\r' && s != '\n' && s != eof; {
-				s = l.next()
-			}
-			l.emit(token.COMMENT)
-		case r == '"':
-			l.backup()
-			if !l.scanString() {
-				return l.errorf("bad string syntax: %s", l.src[l.start:l.pos])
-			}
-			l.emit(token.DESCRIPTION)
-		case isAlphaNumeric(r) && !unicode.IsDigit(r):
-			name := l.scanIdentifier()
-			if name == token.ERR {
-				return l.errorf("malformed field name")
-			}
-			l.emit(name)
-
-			if l.peek() == '(' {
-				ok := l.scanArgDefs()
-				if !ok {
-					return l.errorf("malformed field arguments")
-				}
-			}
-
-			l.ignoreSpace()
-
-			if l.accept(":") {
-				l.emit(token.COLON)
-			}
-
-			l.ignoreSpace()
-
-			if l.accept("@") {
-				l.backup()
-				f := l.scanDirectives(noopStateFn)
-				if f == nil {
-					return nil
-				}
-				break
-			}
-
-			ok := l.scanType()
-			if !ok {
-				return l.errorf("malformed type for field")
-			}
-
-			l.ignoreSpace()
-
-			if l.accept("=") {
-				l.emit(token.ASSIGN)
-				l.ignoreSpace()
-
-				ok := l.scanValue()
-				if !ok {
-					return l.errorf("malformed default value")
-				}
-
-				l.ignoreSpace()
-			}
-
-			if l.accept("@") {
-				l.backup()
-				f := l.scanDirectives(noopStateFn)
-				if f == nil {
-					return nil
-				}
-			}
-		default:
-			return l.errorf("unexpected character in field list: %s", string(r))
-		}
-
-		l.acceptRun(spaceChars)
-		if l.peek() == ',' {
-			l.next()
-			l.acceptRun(spaceChars)
-		}
-		l.ignore()
-	}
-}
-
-func (l *lxr) scanType() bool {
-	r := l.next()
-	switch {
-	case r == eof:
-		return false
-	case r == ' ', r == '\t':
-		l.ignoreSpace()
-		return l.scanType()
-	case r == '[':
-		l.emit(token.LBRACK)
-		ok := l.scanType()
-		if !ok {
-			return false
-		}
-
-		if !l.accept("]") {
-			return false
 		}
-		l.emit(token.RBRACK)
-	case isAlphaNumeric(r) && !unicode.IsDigit(r):
-		name := l.scanIdentifier()
-		if name == token.ERR {
-			return false
-		}
-		l.emit(name)
-	}
-
-	l.ignoreSpace()
-	if l.accept("!") {
-		l.emit(token.NOT)
 	}
 
-	return true
-}
-
-func noopStateFn(*lxr) stateFn { return nil }
-
-func (l *lxr) scanArgDefs() bool {
-	l.accept("(")
-	l.emit(token.LPAREN)
-
-	l.ignoreWhiteSpace()
-
 	for {
-		r := l.next()
-		switch r {
-		case eof:
+		switch l.next() {
+		case eof, '\r', '\n':
 			l.backup()
 			return false
-		case ')':
-			l.emit(token.RPAREN)
-			return true
-		case '#':
-			for s := l.next(); s != '\r' && s != '\n' && s != eof; {
-				s = l.next()
-			}
-			l.emit(token.COMMENT)
-			l.ignoreWhiteSpace()
-			continue
-		case '"':
-			l.backup()
-			if !l.scanString() {
-				return false
-			}
-			l.emit(token.DESCRIPTION)
-			l.ignoreWhiteSpace()
-			continue
-		default:
-			l.backup()
-		}
-
-		name := l.scanIdentifier()
-		if name == token.ERR {
-			return false
-		}
-		l.emit(name)
-
-		l.ignoreSpace()
-
-		if !l.accept(":") {
-			return false
-		}
-		l.emit(token.COLON)
-
-		l.ignoreSpace()
-
-		ok := l.scanType()
-		if !ok {
-			return false
-		}
-
-		l.ignoreSpace()
-
-		if l.accept("=") {
-			l.emit(token.ASSIGN)
-			l.ignoreSpace()
-
-			ok := l.scanValue()
-			if !ok {
-				return false
-			}
-
-			l.ignoreSpace()
-		}
-
-		if l.accept("@") {
-			l.backup()
-			s := l.scanDirectives(noopStateFn)
-			if s == nil {
-				return false
-			}
-		}
-
-		l.acceptRun(spaceChars)
-		if l.peek() == ',' {
+		case '\\':
 			l.next()
-			l.acceptRun(spaceChars)
-		}
-		l.ignore()
-	}
-}
-
-// scanIdentifier scans an identifier and returns its token
-func (l *lxr) scanIdentifier() token.Token {
-	for r := l.next(); isAlphaNumeric(r); {
-		r = l.next()
-	}
-
-	l.backup()
-	word := string(l.src[l.start:l.pos])
-	if !l.atTerminator() {
-		return token.ERR
-	}
-
-	return token.Lookup(word)
-}
-
-func (l *lxr) atTerminator() bool {
-	r := l.peek()
-	if isSpace(r) {
-		return true
-	}
-
-	switch r {
-	case eof, '.', ',', ':', ')', '(', '!', ']', '}':
-		return true
-	}
-	return false
-}
-
-// scanString scans both a block string, `"""` and a normal string `"`
-func (l *lxr) scanString() bool {
-	l.acceptRun("\"")
-	diff := l.pos - l.start
-	if diff != 1 && diff != 3 {
-		return false
-	}
-
-	for r := l.next(); r != '"' && r != eof; {
-		if r == eof {
-			return false
+		case '"':
+			return true
 		}
-		r = l.next()
-	}
-	l.backup()
-	p := l.pos
-	l.acceptRun("\"")
-
-	newDiff := l.pos - p
-
-	if newDiff != diff {
-		return false
 	}
-	return true
 }
 
 // scanNumber scans both an int and a float as defined by the GraphQL spec.
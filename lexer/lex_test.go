@@ -216,6 +216,18 @@ func TestLexer(t *testing.T) {
 				{Typ: token.IDENT, Val: "C"},
 			},
 		},
+		{
+			Name: "InterfaceDeclWithInterfaces",
+			Src:  `interface Test implements A & B`,
+			Items: []Item{
+				{Typ: token.INTERFACE, Val: "interface"},
+				{Typ: token.IDENT, Val: "Test"},
+				{Typ: token.IMPLEMENTS, Val: "implements"},
+				{Typ: token.IDENT, Val: "A"},
+				{Typ: token.AND, Val: "&"},
+				{Typ: token.IDENT, Val: "B"},
+			},
+		},
 		{
 			Name: "TypeDeclWithFields",
 			Src: `type Test {
@@ -535,6 +547,25 @@ func TestLexer(t *testing.T) {
 				{Typ: token.IDENT, Val: "C"},
 			},
 		},
+		{
+			Name: "RepeatableDirective",
+			Src:  `directive @test(a: A) repeatable on A | B`,
+			Items: []Item{
+				{Typ: token.DIRECTIVE, Val: "directive"},
+				{Typ: token.AT, Val: "@"},
+				{Typ: token.IDENT, Val: "test"},
+				{Typ: token.LPAREN, Val: "("},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.IDENT, Val: "A"},
+				{Typ: token.RPAREN, Val: ")"},
+				{Typ: token.IDENT, Val: "repeatable"},
+				{Typ: token.ON, Val: "on"},
+				{Typ: token.IDENT, Val: "A"},
+				{Typ: token.OR, Val: "|"},
+				{Typ: token.IDENT, Val: "B"},
+			},
+		},
 		{
 			Name: "DirectiveWithArgs",
 			Src:  `directive @test(a: A = 1 @a, b: B @a @b, c: C @c(b: {hello: "world!"})) on A | B | C`,
@@ -671,39 +702,246 @@ func TestErrs(t *testing.T) {
 			},
 		},
 		{
-			Name: "MalformedDirectiveArgs",
-			Src:  `@test(`,
+			Name: "UnexpectedCharacter",
+			Src:  `type Test { a: A? }`,
+			Items: []Item{
+				{Typ: token.TYPE, Val: "type"},
+				{Typ: token.IDENT, Val: "Test"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.IDENT, Val: "A"},
+				{Typ: token.ERR, Val: "unexpected character: ?"},
+			},
+		},
+		{
+			Name: "MalformedNumber",
+			Src:  `@test(a: 1.2.3)`,
 			Items: []Item{
 				{Typ: token.AT, Val: "@"},
 				{Typ: token.IDENT, Val: "test"},
 				{Typ: token.LPAREN, Val: "("},
-				{Typ: token.ERR, Val: "invalid arg"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.ERR, Val: "malformed number: 1.2."},
+			},
+		},
+		{
+			Name: "ResumeAfterError",
+			Src: `type Test {
+  a: "abc
+}
+
+type Test`,
+			Items: []Item{
+				{Typ: token.TYPE, Val: "type"},
+				{Typ: token.IDENT, Val: "Test"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.ERR, Val: `bad string syntax: "abc`},
+				{Typ: token.TYPE, Val: "type"},
+				{Typ: token.IDENT, Val: "Test"},
+				{Typ: token.EOF},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			dset := token.NewDocSet()
+
+			l := Lex(dset.AddDoc("", dset.Base(), len(testCase.Src)), testCase.Src)
+
+			expectItems(subT, l, testCase.Items...)
+			l.Drain()
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	src := "type A {\n  a: Int\n}\n\n{ ..a }"
+
+	dset := token.NewDocSet()
+	l := LexQuery(dset.AddDoc("Error", dset.Base(), len(src)), src)
+	defer l.Drain()
+
+	var item Item
+	for item = l.NextItem(); item.Typ != token.ERR; item = l.NextItem() {
+		if item.Typ == token.EOF {
+			t.Fatal("expected error item")
+		}
+	}
+
+	err := item.Err
+	if err == nil {
+		t.Fatalf("expected error details on item: %#v", item)
+	}
+	if err.Code != ErrBadSpread {
+		t.Errorf("expected code: %s but instead received: %s", ErrBadSpread, err.Code)
+	}
+	if err.Pos != item.Pos || err.Found != ".." || err.Msg != item.Val {
+		t.Errorf("expected error to match item: %#v but instead received: %#v", item, err)
+	}
+	if err.Error() != "Error:5:3: expected spread operator: .." {
+		t.Errorf("unexpected error message: %s", err)
+	}
+}
+
+func TestLexQuery(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Src   string
+		Items []Item
+	}{
+		{
+			Name: "Shorthand",
+			Src:  `{ a, b }`,
+			Items: []Item{
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.IDENT, Val: "b"},
+				{Typ: token.RBRACE, Val: "}"},
+			},
+		},
+		{
+			Name: "Operation",
+			Src: `query Test($a: [Int!] = [1, -2.5e3], $b: In = {c: "d"}) @a(b: $a) {
+	alias: field(a: $a, b: """block "quoted" string""") {
+		...Frag
+		... on Type { c }
+	}
+}`,
+			Items: []Item{
+				{Typ: token.IDENT, Val: "query"},
+				{Typ: token.IDENT, Val: "Test"},
+				{Typ: token.LPAREN, Val: "("},
+				{Typ: token.VAR, Val: "$"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.LBRACK, Val: "["},
+				{Typ: token.IDENT, Val: "Int"},
+				{Typ: token.NOT, Val: "!"},
+				{Typ: token.RBRACK, Val: "]"},
+				{Typ: token.ASSIGN, Val: "="},
+				{Typ: token.LBRACK, Val: "["},
+				{Typ: token.INT, Val: "1"},
+				{Typ: token.FLOAT, Val: "-2.5e3"},
+				{Typ: token.RBRACK, Val: "]"},
+				{Typ: token.VAR, Val: "$"},
+				{Typ: token.IDENT, Val: "b"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.IDENT, Val: "In"},
+				{Typ: token.ASSIGN, Val: "="},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "c"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.STRING, Val: `"d"`},
+				{Typ: token.RBRACE, Val: "}"},
+				{Typ: token.RPAREN, Val: ")"},
+				{Typ: token.AT, Val: "@"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.LPAREN, Val: "("},
+				{Typ: token.IDENT, Val: "b"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.VAR, Val: "$"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.RPAREN, Val: ")"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "alias"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.IDENT, Val: "field"},
+				{Typ: token.LPAREN, Val: "("},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.VAR, Val: "$"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.IDENT, Val: "b"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.STRING, Val: `"""block "quoted" string"""`},
+				{Typ: token.RPAREN, Val: ")"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.PERIOD, Val: "..."},
+				{Typ: token.IDENT, Val: "Frag"},
+				{Typ: token.PERIOD, Val: "..."},
+				{Typ: token.ON, Val: "on"},
+				{Typ: token.IDENT, Val: "Type"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "c"},
+				{Typ: token.RBRACE, Val: "}"},
+				{Typ: token.RBRACE, Val: "}"},
+				{Typ: token.RBRACE, Val: "}"},
 			},
 		},
 		{
-			Name: "MalformedDirectiveArgs2",
-			Src:  `@test(a  1)`,
+			Name: "Fragment",
+			Src: `# Comment
+fragment Frag on Type @a { b }`,
 			Items: []Item{
+				{Typ: token.COMMENT, Val: "# Comment\n"},
+				{Typ: token.IDENT, Val: "fragment"},
+				{Typ: token.IDENT, Val: "Frag"},
+				{Typ: token.ON, Val: "on"},
+				{Typ: token.IDENT, Val: "Type"},
 				{Typ: token.AT, Val: "@"},
-				{Typ: token.IDENT, Val: "test"},
+				{Typ: token.IDENT, Val: "a"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "b"},
+				{Typ: token.RBRACE, Val: "}"},
+			},
+		},
+		{
+			Name: "Strings",
+			Src:  `{ a(b: "", c: "\"", d: """a \""" b""") }`,
+			Items: []Item{
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "a"},
 				{Typ: token.LPAREN, Val: "("},
+				{Typ: token.IDENT, Val: "b"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.STRING, Val: `""`},
+				{Typ: token.IDENT, Val: "c"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.STRING, Val: `"\""`},
+				{Typ: token.IDENT, Val: "d"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.STRING, Val: `"""a \""" b"""`},
+				{Typ: token.RPAREN, Val: ")"},
+				{Typ: token.RBRACE, Val: "}"},
+			},
+		},
+		{
+			Name: "BadString",
+			Src:  `{ a(b: "c`,
+			Items: []Item{
+				{Typ: token.LBRACE, Val: "{"},
 				{Typ: token.IDENT, Val: "a"},
-				{Typ: token.ERR, Val: "invalid arg"},
+				{Typ: token.LPAREN, Val: "("},
+				{Typ: token.IDENT, Val: "b"},
+				{Typ: token.COLON, Val: ":"},
+				{Typ: token.ERR, Val: `bad string syntax: "c`},
 			},
 		},
 		{
-			Name: "UnknownTypeDecl",
-			Src:  `unknownType Test`,
+			Name: "BadSpread",
+			Src:  `{ ..a }`,
 			Items: []Item{
-				{Typ: token.ERR, Val: "invalid type declaration"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.ERR, Val: "expected spread operator: .."},
 			},
 		},
 		{
-			Name: "InvalidTypeExtension",
-			Src:  `extend unknownType Test`,
+			Name: "ResumeAfterError",
+			Src: `{ ..a }
+query { b }`,
 			Items: []Item{
-				{Typ: token.EXTEND, Val: "extend"},
-				{Typ: token.ERR, Val: "invalid type extension"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.ERR, Val: "expected spread operator: .."},
+				{Typ: token.IDENT, Val: "query"},
+				{Typ: token.LBRACE, Val: "{"},
+				{Typ: token.IDENT, Val: "b"},
+				{Typ: token.RBRACE, Val: "}"},
+				{Typ: token.EOF},
 			},
 		},
 	}
@@ -712,7 +950,7 @@ func TestErrs(t *testing.T) {
 		t.Run(testCase.Name, func(subT *testing.T) {
 			dset := token.NewDocSet()
 
-			l := Lex(dset.AddDoc("", dset.Base(), len(testCase.Src)), testCase.Src)
+			l := LexQuery(dset.AddDoc(testCase.Name, dset.Base(), len(testCase.Src)), testCase.Src)
 
 			expectItems(subT, l, testCase.Items...)
 			l.Drain()
@@ -0,0 +1,213 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gqlc/graphql/lexer"
+	"github.com/gqlc/graphql/token"
+)
+
+// ErrorCode classifies a parse error. Codes are stable, new codes
+// are only ever appended.
+//
+type ErrorCode int
+
+// Parse error codes
+const (
+	ErrUnknown                  ErrorCode = iota // unclassified error
+	ErrLexical                                   // error reported by the lexer; see Error.Lex
+	ErrUnexpectedToken                           // token is not valid in the current context
+	ErrUnexpectedEOF                             // document ended in the middle of a definition
+	ErrUnknownType                               // unknown type declaration keyword
+	ErrInvalidDirectiveLocation                  // unknown directive location
+	ErrInvalidFragmentName                       // fragment named "on"
+	ErrNonConstantDefault                        // variable default value references a variable
+	ErrEmptySelectionSet                         // selection set without any selections
+	ErrBadIntrospection                          // malformed or unexpected introspection result
+	ErrNonConstantValue                          // variable in a constant value e.g. an SDL argument
+)
+
+var errorCodes = [...]string{
+	ErrUnknown:                  "Unknown",
+	ErrLexical:                  "Lexical",
+	ErrUnexpectedToken:          "UnexpectedToken",
+	ErrUnexpectedEOF:            "UnexpectedEOF",
+	ErrUnknownType:              "UnknownType",
+	ErrInvalidDirectiveLocation: "InvalidDirectiveLocation",
+	ErrInvalidFragmentName:      "InvalidFragmentName",
+	ErrNonConstantDefault:       "NonConstantDefault",
+	ErrEmptySelectionSet:        "EmptySelectionSet",
+	ErrBadIntrospection:         "BadIntrospection",
+	ErrNonConstantValue:         "NonConstantValue",
+}
+
+func (c ErrorCode) String() string {
+	if 0 <= c && int(c) < len(errorCodes) {
+		return errorCodes[c]
+	}
+	return "ErrorCode(" + strconv.Itoa(int(c)) + ")"
+}
+
+// Error represents a single parsing error.
+type Error struct {
+	Pos      token.Pos      // position of the offending token
+	Position token.Position // full position of the offending token
+	Code     ErrorCode
+	Found    lexer.Item    // offending token
+	Expected []token.Token // expected tokens; or nil, if unknown
+	Msg      string
+
+	Lex *lexer.Error // lexical error; set iff Code is ErrLexical
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Position.Filename != "" || e.Position.IsValid() {
+		return e.Position.String() + ": " + e.Msg
+	}
+	return e.Msg
+}
+
+// Unwrap returns the underlying lexical error, if any.
+func (e *Error) Unwrap() error {
+	if e.Lex == nil {
+		return nil
+	}
+	return e.Lex
+}
+
+// ErrorList is a list of *Errors.
+// The zero value for an ErrorList is an empty ErrorList ready to use.
+//
+type ErrorList []*Error
+
+// Add adds an Error with given position and error message to an ErrorList.
+func (p *ErrorList) Add(pos token.Position, msg string) {
+	*p = append(*p, &Error{Position: pos, Msg: msg})
+}
+
+// Reset resets an ErrorList to no errors.
+func (p *ErrorList) Reset() { *p = (*p)[0:0] }
+
+// ErrorList implements the sort Interface.
+func (p ErrorList) Len() int      { return len(p) }
+func (p ErrorList) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+
+func (p ErrorList) Less(i, j int) bool {
+	e := &p[i].Position
+	f := &p[j].Position
+	if e.Filename != f.Filename {
+		return e.Filename < f.Filename
+	}
+	if e.Line != f.Line {
+		return e.Line < f.Line
+	}
+	if e.Column != f.Column {
+		return e.Column < f.Column
+	}
+	return p[i].Msg < p[j].Msg
+}
+
+// Sort sorts an ErrorList by position, then by error message.
+func (p ErrorList) Sort() {
+	sort.Sort(p)
+}
+
+// An ErrorList implements the error interface.
+func (p ErrorList) Error() string {
+	switch len(p) {
+	case 0:
+		return "no errors"
+	case 1:
+		return p[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", p[0], len(p)-1)
+}
+
+// Err returns an error equivalent to this error list.
+// If the list is empty, Err returns nil.
+func (p ErrorList) Err() error {
+	if len(p) == 0 {
+		return nil
+	}
+	return p
+}
+
+// ResponseError is an error reported by a GraphQL response,
+// as found in its "errors" list.
+//
+type ResponseError struct {
+	Message    string                 `json:"message"`
+	Locations  []Location             `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Location is a location in the GraphQL document a ResponseError refers to.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Error implements the error interface.
+func (e *ResponseError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+
+	path := make([]string, len(e.Path))
+	for i, p := range e.Path {
+		path[i] = fmt.Sprint(p)
+	}
+	return strings.Join(path, ".") + ": " + e.Message
+}
+
+// ResponseErrors is the list of errors reported by a GraphQL response.
+type ResponseErrors []*ResponseError
+
+// A ResponseErrors implements the error interface.
+func (p ResponseErrors) Error() string {
+	switch len(p) {
+	case 0:
+		return "no errors"
+	case 1:
+		return "response error: " + p[0].Error()
+	}
+	return fmt.Sprintf("response error: %s (and %d more errors)", p[0], len(p)-1)
+}
+
+// describe returns a human readable description of the token type.
+func describe(tok token.Token) string {
+	switch {
+	case tok == token.IDENT:
+		return "name"
+	case tok == token.EOF:
+		return "EOF"
+	case tok.IsKeyword():
+		return `"` + strings.ToLower(tok.String()) + `"`
+	case tok.IsOperator():
+		return "'" + operators[tok] + "'"
+	}
+	return strings.ToLower(tok.String())
+}
+
+var operators = map[token.Token]string{
+	token.AND:    "&",
+	token.OR:     "|",
+	token.NOT:    "!",
+	token.AT:     "@",
+	token.VAR:    "$",
+	token.ASSIGN: "=",
+	token.LPAREN: "(",
+	token.LBRACK: "[",
+	token.LBRACE: "{",
+	token.COMMA:  ",",
+	token.PERIOD: "...",
+	token.RPAREN: ")",
+	token.RBRACK: "]",
+	token.RBRACE: "}",
+	token.COLON:  ":",
+}
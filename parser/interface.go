@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 
 	"github.com/gqlc/graphql/ast"
-	"github.com/gqlc/graphql/lexer/introspect"
 	"github.com/gqlc/graphql/token"
 )
 
@@ -18,19 +17,30 @@ type Mode uint
 // Mode Options
 const (
 	ParseComments = 1 << iota // parse comments and add them to the schema
+	AllErrors                 // report all errors, not just the first
 )
 
 // ParseDir calls ParseDoc for all files with names ending in ".gql"/".graphql" in the
-// directory specified by path and returns a map of document name -> *ast.Document for all
-// the documents found.
+// directory specified by path, and its subdirectories, and returns a map of file path -> *ast.Document
+// for all the documents found. Documents are named by their file path, since file names
+// are not unique across directories.
+//
+// A file with syntax errors is left out of the map and the remaining files are still
+// parsed; the syntax errors of all files are returned together, as an ErrorList. Any
+// other error e.g. one reading a file stops the walk.
 //
 func ParseDir(dset *token.DocSet, path string, filter func(os.FileInfo) bool, mode Mode) (docs map[string]*ast.Document, err error) {
 	if filter == nil {
 		filter = func(os.FileInfo) bool { return false }
 	}
 
+	var errs ErrorList
 	docs = make(map[string]*ast.Document)
 	err = filepath.Walk(path, func(p string, info os.FileInfo, e error) error {
+		if e != nil {
+			return e
+		}
+
 		skip := filter(info)
 		if skip && info.IsDir() {
 			return filepath.SkipDir
@@ -46,8 +56,12 @@ func ParseDir(dset *token.DocSet, path string, filter func(os.FileInfo) bool, mo
 			return err
 		}
 
-		doc, err := ParseDoc(dset, info.Name(), f, mode)
+		doc, err := ParseDoc(dset, p, f, mode)
 		f.Close() // TODO: Handle this error
+		if list, ok := err.(ErrorList); ok {
+			errs = append(errs, list...)
+			return nil
+		}
 		if err != nil {
 			return err
 		}
@@ -55,10 +69,18 @@ func ParseDir(dset *token.DocSet, path string, filter func(os.FileInfo) bool, mo
 		docs[doc.Name] = doc
 		return nil
 	})
+	if err == nil {
+		err = errs.Err()
+	}
 	return
 }
 
 // ParseDoc parses a single GraphQL Document.
+//
+// If syntax errors were found, the result is a partial document and
+// the error is an ErrorList. In AllErrors mode, the parser resumes at
+// the next top-level definition after each error, so every error is reported.
+//
 func ParseDoc(dset *token.DocSet, name string, src io.Reader, mode Mode) (*ast.Document, error) {
 	// Assume src isn't massive so we're gonna just read it all
 	b, err := ioutil.ReadAll(src)
@@ -102,39 +124,50 @@ func ParseDocs(dset *token.DocSet, docs map[string]io.Reader, mode Mode) ([]*ast
 }
 
 // ParseIntrospection parses the results of an introspection query. The results
-// in src should be JSON encoded.
+// in src should be JSON encoded, either as a whole GraphQL response i.e.
+// {"data": {"__schema": ...}, "errors": ...} or as just its data. Keys may
+// come in any order and unknown keys, such as extensions, are ignored.
 //
-func ParseIntrospection(dset *token.DocSet, name string, src io.Reader) (doc *ast.Document, err error) {
-	// Create parser and doc to doc set. Then, parse doc.
-	d := dset.AddDoc(name, -1, 500) // TODO: Get size of src
+// Each node is positioned at the JSON value it's decoded from, e.g. a field
+// at its __Field object, so positions point into src. Descriptions are kept
+// as docs of their nodes, quoted like in SDL, but without a position.
+//
+// If the response reports errors, the error is a ResponseErrors. Otherwise,
+// errors are reported as an ErrorList, which holds the first error.
+//
+func ParseIntrospection(dset *token.DocSet, name string, src io.Reader) (*ast.Document, error) {
+	b, err := ioutil.ReadAll(src)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &decoder{
+		doc:  dset.AddDoc(name, -1, len(b)),
+		name: name,
+		src:  b,
+	}
+	d.doc.SetLinesForContent(b)
+
+	return d.decode()
+}
+
+// ParseQuery parses a single GraphQL executable document i.e. a
+// set of operations (queries, mutations and subscriptions) and fragments.
+// Errors are reported the same as for ParseDoc.
+//
+func ParseQuery(dset *token.DocSet, name string, src io.Reader, mode Mode) (*ast.ExecutableDocument, error) {
+	b, err := ioutil.ReadAll(src)
+	if err != nil {
+		return nil, err
+	}
+
+	d := dset.AddDoc(name, -1, len(b))
 	p := &parser{
 		name:   name,
-		dg:     make([]*ast.DocGroup_Doc, 0, 4),
-		cdg:    make([]*ast.DocGroup_Doc, 0, 4),
 		direcs: make([]*ast.DirectiveLit, 0, 4),
 		dargs:  make([]*ast.Arg, 0, 5),
-		fields: make([]*ast.Field, 0, 5),
-		args:   make([]*ast.InputValue, 0, 5),
-		fargs:  make([]*ast.InputValue, 0, 5),
 	}
 	p.pk.Line = -1
 
-	defer p.recover(&err)
-	p.l = introspect.Lex(d, src)
-	p.doc = d
-
-	doc = &ast.Document{
-		Name: p.name,
-	}
-	docs := p.parseDoc(&doc.Types, &doc.Directives)
-	if len(docs) > 0 {
-		doc.Doc = &ast.DocGroup{
-			List: docs,
-		}
-	}
-
-	if p.schema != nil {
-		doc.Schema = p.schema
-	}
-	return
+	return p.parseQuery(d, b, mode)
 }
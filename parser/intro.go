@@ -0,0 +1,1006 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/lexer"
+	"github.com/gqlc/graphql/token"
+)
+
+// decoder decodes the results of an introspection query into an ast.Document.
+//
+// The JSON is decoded in a single pass. Since keys may come in any order, each
+// object is decoded into typed values first, which are converted to nodes once
+// the object is complete. Nodes are positioned at the offset of the JSON value
+// they're decoded from, which is an object for most of them.
+//
+type decoder struct {
+	doc  *token.Doc
+	name string
+	src  []byte
+	off  int // offset of the next byte in src
+
+	errors ErrorList
+
+	types  []*ast.TypeDecl
+	schema *ast.TypeDecl
+	found  bool // whether __schema has been decoded
+}
+
+func (d *decoder) decode() (doc *ast.Document, err error) {
+	defer d.recover(&err)
+
+	off := d.next()
+	errs := d.response()
+	switch {
+	case len(errs) > 0:
+		return nil, errs
+	case !d.found:
+		d.errorf(off, ErrBadIntrospection, "missing __schema")
+	}
+
+	return &ast.Document{
+		Name:   d.name,
+		Schema: d.schema,
+		Types:  d.types,
+	}, nil
+}
+
+// pos returns the position of the src at off.
+func (d *decoder) pos(off int) int64 {
+	return int64(d.doc.Pos(off))
+}
+
+// errorf formats the error for the src at off and terminates decoding.
+func (d *decoder) errorf(off int, code ErrorCode, format string, args ...interface{}) {
+	pos := d.doc.Pos(off)
+	d.errors = append(d.errors, &Error{
+		Pos:      pos,
+		Position: d.doc.Position(pos),
+		Code:     code,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+	panic(bailout{})
+}
+
+// expected complains about the JSON value at the current offset.
+func (d *decoder) expected(what string) {
+	d.errorf(d.next(), ErrBadIntrospection, "expected %s, found %s", what, d.describe())
+}
+
+// recover is the handler that turns panics into returns from decode.
+func (d *decoder) recover(err *error) {
+	if e := recover(); e != nil {
+		if _, ok := e.(bailout); !ok {
+			panic(e)
+		}
+		*err = d.errors.Err()
+	}
+}
+
+// response decodes a GraphQL response, whose data holds the result of an
+// introspection query, or just that result i.e. an object with a __schema
+// key. Unknown keys, such as extensions, are ignored.
+//
+func (d *decoder) response() (errs ResponseErrors) {
+	d.object("response object", func(key string) {
+		switch key {
+		case "__schema":
+			d.decodeSchema()
+		case "data":
+			if d.null() {
+				return
+			}
+			d.object("data object", func(key string) {
+				if key != "__schema" {
+					d.skip()
+					return
+				}
+				d.decodeSchema()
+			})
+		case "errors":
+			if d.null() {
+				return
+			}
+			d.array("errors array", func() {
+				off := d.next()
+				d.skip()
+
+				e := new(ResponseError)
+				if err := json.Unmarshal(d.src[off:d.off], e); err != nil {
+					d.errorf(off, ErrBadIntrospection, "malformed response error: %s", err)
+				}
+				errs = append(errs, e)
+			})
+		default:
+			d.skip()
+		}
+	})
+
+	if off := d.next(); off < len(d.src) {
+		d.errorf(off, ErrBadIntrospection, "unexpected %s after response", d.describe())
+	}
+	return
+}
+
+// rootOps are the root operations, in the order of a schema declaration.
+var rootOps = [...]string{"query", "mutation", "subscription"}
+
+// decodeSchema decodes a __Schema. Its types and directives are appended to the
+// document's declarations as they are decoded, followed by a schema declaration,
+// if the root operation types are known.
+//
+func (d *decoder) decodeSchema() {
+	if d.null() {
+		return
+	}
+	if d.found {
+		d.errorf(d.next(), ErrBadIntrospection, "duplicate __schema")
+	}
+	d.found = true
+
+	var (
+		descr *string
+		roots [len(rootOps)]*ast.Field
+	)
+	off := d.object("__schema object", func(key string) {
+		switch key {
+		case "description":
+			descr = d.optString("schema description")
+		case "queryType":
+			roots[0] = d.rootOp(rootOps[0])
+		case "mutationType":
+			roots[1] = d.rootOp(rootOps[1])
+		case "subscriptionType":
+			roots[2] = d.rootOp(rootOps[2])
+		case "types":
+			d.optArray("types array", func() {
+				d.types = append(d.types, d.typeDecl())
+			})
+		case "directives":
+			d.optArray("directives array", func() {
+				d.types = append(d.types, d.directiveDecl())
+			})
+		default:
+			d.skip()
+		}
+	})
+
+	ops := &ast.FieldList{Opening: d.pos(off), Closing: d.pos(off)}
+	for _, f := range roots {
+		if f != nil {
+			ops.List = append(ops.List, f)
+		}
+	}
+	if len(ops.List) == 0 {
+		return
+	}
+
+	d.schema = &ast.TypeDecl{
+		Doc:    docGroup(descr),
+		TokPos: d.pos(off),
+		Tok:    token.SCHEMA,
+		Spec: &ast.TypeDecl_TypeSpec{TypeSpec: &ast.TypeSpec{
+			Type: &ast.TypeSpec_Schema{Schema: &ast.SchemaType{
+				Schema:  d.pos(off),
+				RootOps: ops,
+			}},
+		}},
+	}
+	d.types = append(d.types, d.schema)
+}
+
+// rootOp decodes the named type of the root operation op, which may be null.
+func (d *decoder) rootOp(op string) *ast.Field {
+	if d.null() {
+		return nil
+	}
+
+	var name string
+	off := d.object(op+" type object", func(key string) {
+		if key != "name" {
+			d.skip()
+			return
+		}
+		name = d.string(op + " type name")
+	})
+	if name == "" {
+		d.errorf(off, ErrBadIntrospection, "missing name of %s type", op)
+	}
+
+	return &ast.Field{
+		Name: &ast.Ident{NamePos: d.pos(off), Name: op},
+		Type: &ast.Field_Ident{Ident: &ast.Ident{NamePos: d.pos(off), Name: name}},
+	}
+}
+
+// typeKinds maps the kinds of named types to the keywords declaring them.
+var typeKinds = map[string]token.Token{
+	"SCALAR":       token.SCALAR,
+	"OBJECT":       token.TYPE,
+	"INTERFACE":    token.INTERFACE,
+	"UNION":        token.UNION,
+	"ENUM":         token.ENUM,
+	"INPUT_OBJECT": token.INPUT,
+}
+
+// introType is a __Type of the types of a schema.
+type introType struct {
+	kind, name     string
+	kindOff        int
+	description    *string
+	specifiedByURL *string
+	specifiedByOff int
+
+	fields        *ast.FieldList
+	inputFields   *ast.InputValueList
+	enumValues    *ast.FieldList
+	interfaces    []*ast.Ident
+	implOff       int
+	possibleTypes []*ast.Ident
+}
+
+// typeDecl decodes a __Type into the declaration of a named type.
+func (d *decoder) typeDecl() *ast.TypeDecl {
+	var t introType
+	off := d.object("type object", func(key string) {
+		switch key {
+		case "kind":
+			t.kindOff = d.next()
+			t.kind = d.string("type kind")
+		case "name":
+			t.name = d.string("type name")
+		case "description":
+			t.description = d.optString("type description")
+		case "specifiedByURL":
+			t.specifiedByOff = d.next()
+			t.specifiedByURL = d.optString("specifiedByURL")
+		case "fields":
+			t.fields = d.fieldList("fields array", d.field)
+		case "inputFields":
+			t.inputFields = d.inputValueList("input fields array")
+		case "enumValues":
+			t.enumValues = d.fieldList("enum values array", d.enumValue)
+		case "interfaces":
+			t.implOff = d.next()
+			t.interfaces = d.namedTypes("interfaces array")
+		case "possibleTypes":
+			t.possibleTypes = d.namedTypes("possible types array")
+		case "ofType":
+			if !d.null() {
+				d.errorf(d.next(), ErrBadIntrospection, "unexpected ofType of type declaration")
+			}
+		default:
+			d.skip()
+		}
+	})
+
+	tok, ok := typeKinds[t.kind]
+	switch {
+	case t.kind == "":
+		d.errorf(off, ErrBadIntrospection, "missing kind of type")
+	case !ok:
+		d.errorf(t.kindOff, ErrUnknownType, "unknown type kind: %s", t.kind)
+	case t.name == "":
+		d.errorf(off, ErrBadIntrospection, "missing name of type")
+	}
+
+	pos := d.pos(off)
+	ts := &ast.TypeSpec{
+		Name: &ast.Ident{NamePos: pos, Name: t.name},
+	}
+	switch tok {
+	case token.SCALAR:
+		ts.Type = &ast.TypeSpec_Scalar{Scalar: &ast.ScalarType{Scalar: pos, Name: ts.Name}}
+		if t.specifiedByURL != nil {
+			ts.Directives = append(ts.Directives, d.directive("specifiedBy", "url", t.specifiedByURL, t.specifiedByOff))
+		}
+	case token.TYPE:
+		obj := &ast.ObjectType{Object: pos, Interfaces: t.interfaces, Fields: t.fields}
+		if len(t.interfaces) > 0 {
+			obj.ImplPos = d.pos(t.implOff)
+		}
+		ts.Type = &ast.TypeSpec_Object{Object: obj}
+	case token.INTERFACE:
+		inter := &ast.InterfaceType{Interface: pos, Interfaces: t.interfaces, Fields: t.fields}
+		if len(t.interfaces) > 0 {
+			inter.ImplPos = d.pos(t.implOff)
+		}
+		ts.Type = &ast.TypeSpec_Interface{Interface: inter}
+	case token.UNION:
+		ts.Type = &ast.TypeSpec_Union{Union: &ast.UnionType{Union: pos, Members: t.possibleTypes}}
+	case token.ENUM:
+		ts.Type = &ast.TypeSpec_Enum{Enum: &ast.EnumType{Enum: pos, Values: t.enumValues}}
+	case token.INPUT:
+		ts.Type = &ast.TypeSpec_Input{Input: &ast.InputType{Input: pos, Fields: t.inputFields}}
+	}
+
+	return &ast.TypeDecl{
+		Doc:    docGroup(t.description),
+		TokPos: pos,
+		Tok:    tok,
+		Spec:   &ast.TypeDecl_TypeSpec{TypeSpec: ts},
+	}
+}
+
+// directiveDecl decodes a __Directive into the declaration of a directive.
+func (d *decoder) directiveDecl() *ast.TypeDecl {
+	var (
+		name  string
+		descr *string
+	)
+	directive := new(ast.DirectiveType)
+	off := d.object("directive object", func(key string) {
+		switch key {
+		case "name":
+			name = d.string("directive name")
+		case "description":
+			descr = d.optString("directive description")
+		case "args":
+			directive.Args = d.inputValueList("arguments array")
+		case "isRepeatable":
+			directive.RepeatablePos = d.pos(d.next())
+			directive.Repeatable = d.boolean("isRepeatable")
+		case "locations":
+			directive.OnPos = d.pos(d.next())
+			d.optArray("locations array", func() {
+				off := d.next()
+				s := d.string("directive location")
+				loc, ok := ast.IsValidLoc(s)
+				if !ok {
+					d.errorf(off, ErrInvalidDirectiveLocation, "invalid directive location: %s", s)
+				}
+				directive.Locs = append(directive.Locs, &ast.DirectiveLocation{Start: d.pos(off), Loc: loc})
+			})
+		default:
+			d.skip()
+		}
+	})
+	if name == "" {
+		d.errorf(off, ErrBadIntrospection, "missing name of directive")
+	}
+	if len(directive.Locs) == 0 {
+		d.errorf(off, ErrBadIntrospection, "missing locations of directive @%s", name)
+	}
+	if !directive.Repeatable {
+		directive.RepeatablePos = 0
+	}
+
+	pos := d.pos(off)
+	directive.Directive = pos
+	return &ast.TypeDecl{
+		Doc:    docGroup(descr),
+		TokPos: pos,
+		Tok:    token.DIRECTIVE,
+		Spec: &ast.TypeDecl_TypeSpec{TypeSpec: &ast.TypeSpec{
+			Name: &ast.Ident{NamePos: pos, Name: name},
+			Type: &ast.TypeSpec_Directive{Directive: directive},
+		}},
+	}
+}
+
+// fieldList decodes an array of fields or enum values, which may be null.
+// The list is nil, unless there's at least one.
+//
+func (d *decoder) fieldList(what string, f func() *ast.Field) *ast.FieldList {
+	l := new(ast.FieldList)
+	opening, closing := d.optArray(what, func() {
+		l.List = append(l.List, f())
+	})
+	if len(l.List) == 0 {
+		return nil
+	}
+	l.Opening, l.Closing = d.pos(opening), d.pos(closing)
+	return l
+}
+
+// inputValueList decodes an array of arguments or input fields, which may
+// be null. The list is nil, unless there's at least one.
+//
+func (d *decoder) inputValueList(what string) *ast.InputValueList {
+	l := new(ast.InputValueList)
+	opening, closing := d.optArray(what, func() {
+		l.List = append(l.List, d.inputValue())
+	})
+	if len(l.List) == 0 {
+		return nil
+	}
+	l.Opening, l.Closing = d.pos(opening), d.pos(closing)
+	return l
+}
+
+// field decodes a __Field.
+func (d *decoder) field() *ast.Field {
+	var (
+		name, descr *string
+		dep         deprecation
+	)
+	f := new(ast.Field)
+	off := d.object("field object", func(key string) {
+		switch key {
+		case "name":
+			name = d.optString("field name")
+		case "description":
+			descr = d.optString("field description")
+		case "args":
+			f.Args = d.inputValueList("arguments array")
+		case "type":
+			switch v := d.typeRef("field type object").(type) {
+			case *ast.Ident:
+				f.Type = &ast.Field_Ident{Ident: v}
+			case *ast.List:
+				f.Type = &ast.Field_List{List: v}
+			case *ast.NonNull:
+				f.Type = &ast.Field_NonNull{NonNull: v}
+			}
+		case "isDeprecated", "deprecationReason":
+			d.deprecation(key, &dep)
+		default:
+			d.skip()
+		}
+	})
+	if name == nil || *name == "" {
+		d.errorf(off, ErrBadIntrospection, "missing name of field")
+	}
+	if f.Type == nil {
+		d.errorf(off, ErrBadIntrospection, "missing type of field %s", *name)
+	}
+
+	f.Doc = docGroup(descr)
+	f.Name = &ast.Ident{NamePos: d.pos(off), Name: *name}
+	f.Directives = d.deprecated(dep)
+	return f
+}
+
+// enumValue decodes an __EnumValue.
+func (d *decoder) enumValue() *ast.Field {
+	var (
+		name, descr *string
+		dep         deprecation
+	)
+	off := d.object("enum value object", func(key string) {
+		switch key {
+		case "name":
+			name = d.optString("enum value name")
+		case "description":
+			descr = d.optString("enum value description")
+		case "isDeprecated", "deprecationReason":
+			d.deprecation(key, &dep)
+		default:
+			d.skip()
+		}
+	})
+	if name == nil || *name == "" {
+		d.errorf(off, ErrBadIntrospection, "missing name of enum value")
+	}
+
+	return &ast.Field{
+		Doc:        docGroup(descr),
+		Name:       &ast.Ident{NamePos: d.pos(off), Name: *name},
+		Directives: d.deprecated(dep),
+	}
+}
+
+// inputValue decodes an __InputValue.
+func (d *decoder) inputValue() *ast.InputValue {
+	var (
+		name, descr, def *string
+		defOff           int
+		dep              deprecation
+	)
+	v := new(ast.InputValue)
+	off := d.object("input value object", func(key string) {
+		switch key {
+		case "name":
+			name = d.optString("input value name")
+		case "description":
+			descr = d.optString("input value description")
+		case "type":
+			switch t := d.typeRef("input value type object").(type) {
+			case *ast.Ident:
+				v.Type = &ast.InputValue_Ident{Ident: t}
+			case *ast.List:
+				v.Type = &ast.InputValue_List{List: t}
+			case *ast.NonNull:
+				v.Type = &ast.InputValue_NonNull{NonNull: t}
+			}
+		case "defaultValue":
+			defOff = d.next()
+			def = d.optString("default value")
+		case "isDeprecated", "deprecationReason":
+			d.deprecation(key, &dep)
+		default:
+			d.skip()
+		}
+	})
+	if name == nil || *name == "" {
+		d.errorf(off, ErrBadIntrospection, "missing name of input value")
+	}
+	if v.Type == nil {
+		d.errorf(off, ErrBadIntrospection, "missing type of input value %s", *name)
+	}
+
+	v.Doc = docGroup(descr)
+	v.Name = &ast.Ident{NamePos: d.pos(off), Name: *name}
+	if def != nil {
+		switch lit := d.literal(*def, defOff).(type) {
+		case *ast.BasicLit:
+			v.Default = &ast.InputValue_BasicLit{BasicLit: lit}
+		case *ast.CompositeLit:
+			v.Default = &ast.InputValue_CompositeLit{CompositeLit: lit}
+		}
+	}
+	v.Directives = d.deprecated(dep)
+	return v
+}
+
+// typeRef decodes a __Type referencing a type i.e. a named type, list or
+// non-null type, which is returned as an *ast.Ident, *ast.List or *ast.NonNull.
+//
+func (d *decoder) typeRef(what string) interface{} {
+	var (
+		kind, name *string
+		ofType     interface{}
+	)
+	off := d.object(what, func(key string) {
+		switch key {
+		case "kind":
+			kind = d.optString("type kind")
+		case "name":
+			name = d.optString("type name")
+		case "ofType":
+			if !d.null() {
+				ofType = d.typeRef("ofType object")
+			}
+		default:
+			d.skip()
+		}
+	})
+
+	switch {
+	case kind != nil && *kind == "LIST":
+		l := new(ast.List)
+		switch t := ofType.(type) {
+		case *ast.Ident:
+			l.Type = &ast.List_Ident{Ident: t}
+		case *ast.List:
+			l.Type = &ast.List_List{List: t}
+		case *ast.NonNull:
+			l.Type = &ast.List_NonNull{NonNull: t}
+		default:
+			d.errorf(off, ErrBadIntrospection, "missing ofType of list type")
+		}
+		return l
+	case kind != nil && *kind == "NON_NULL":
+		switch t := ofType.(type) {
+		case *ast.Ident:
+			return &ast.NonNull{Type: &ast.NonNull_Ident{Ident: t}}
+		case *ast.List:
+			return &ast.NonNull{Type: &ast.NonNull_List{List: t}}
+		case *ast.NonNull:
+			d.errorf(off, ErrBadIntrospection, "non-null type of non-null type")
+		default:
+			d.errorf(off, ErrBadIntrospection, "missing ofType of non-null type")
+		}
+	}
+
+	// the kind of a named type is irrelevant to a reference
+	if name == nil || *name == "" {
+		d.errorf(off, ErrBadIntrospection, "missing name of type reference")
+	}
+	return &ast.Ident{NamePos: d.pos(off), Name: *name}
+}
+
+// namedTypes decodes an array of references to named types, which may be null.
+func (d *decoder) namedTypes(what string) (idents []*ast.Ident) {
+	d.optArray(what, func() {
+		off := d.next()
+		ident, ok := d.typeRef("type reference object").(*ast.Ident)
+		if !ok {
+			d.errorf(off, ErrBadIntrospection, "expected named type in %s", what)
+		}
+		idents = append(idents, ident)
+	})
+	return
+}
+
+// literal parses the GraphQL literal s, such as a default value, from the JSON
+// string at off. Its nodes are positioned at that string, since the offsets of
+// the literal within it can't be mapped to src once it's unescaped.
+//
+func (d *decoder) literal(s string, off int) (v interface{}) {
+	p := &parser{
+		name: d.name,
+		doc:  token.NewDocSet().AddDoc(d.name, -1, len(s)),
+	}
+	p.pk.Line = -1
+
+	err := func() (err error) {
+		defer p.recover(&err)
+		p.l = lexer.LexQuery(p.doc, s) // a string can't be a description
+
+		v = p.parseValue(true)
+		if item := p.next(); item.Typ != token.EOF {
+			p.unexpected(item)
+		}
+		return
+	}()
+	if err != nil {
+		d.errorf(off, ErrBadIntrospection, "invalid default value %q: %s", s, err.(ErrorList)[0].Msg)
+	}
+
+	pos := d.pos(off)
+	ast.Inspect(v.(ast.Node), func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.Ident:
+			x.NamePos = pos
+		case *ast.BasicLit:
+			x.ValuePos = pos
+		case *ast.CompositeLit:
+			x.Opening, x.Closing = pos, pos
+		}
+		return true
+	})
+	return v
+}
+
+// docGroup returns the doc of the description descr, if any. Like in SDL,
+// its text is a string literal. It has no position, since the description
+// of an object in JSON doesn't precede the object's node, as it does in SDL.
+//
+func docGroup(descr *string) *ast.DocGroup {
+	if descr == nil || *descr == "" {
+		return nil
+	}
+	return &ast.DocGroup{List: []*ast.DocGroup_Doc{{Text: stringLiteral(*descr)}}}
+}
+
+// stringLiteral returns s as a string literal. Text spanning several lines is
+// written as a block string, unless its value would differ from s, e.g. since
+// all of its lines are indented.
+//
+func stringLiteral(s string) string {
+	lines := strings.Split(s, "\n")
+	block := len(lines) > 1 && !strings.Contains(s, "\r") &&
+		strings.TrimLeft(lines[0], " \t") != "" &&
+		strings.TrimLeft(lines[len(lines)-1], " \t") != ""
+	if block {
+		indented := true
+		for _, l := range lines {
+			if l != "" && l[0] != ' ' && l[0] != '\t' {
+				indented = false
+				break
+			}
+		}
+		block = !indented
+	}
+	if block {
+		return "\"\"\"\n" + strings.Replace(s, `"""`, `\"""`, -1) + "\n\"\"\""
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04X`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// defaultReason is the default reason of the @deprecated directive.
+const defaultReason = "No longer supported"
+
+// deprecation collects the isDeprecated and deprecationReason
+// of a field, enum value or input value, in any order.
+//
+type deprecation struct {
+	deprecated bool
+	off        int // offset of isDeprecated
+	reason     *string
+}
+
+func (d *decoder) deprecation(key string, dep *deprecation) {
+	switch key {
+	case "isDeprecated":
+		dep.off = d.next()
+		dep.deprecated = d.boolean("isDeprecated")
+	case "deprecationReason":
+		dep.reason = d.optString("deprecationReason")
+	}
+}
+
+// deprecated returns the @deprecated directive, if dep is deprecated.
+// Like printers of SDL, the reason is omitted if it's the default one.
+//
+func (d *decoder) deprecated(dep deprecation) []*ast.DirectiveLit {
+	if !dep.deprecated {
+		return nil
+	}
+
+	reason := dep.reason
+	if reason != nil && *reason == defaultReason {
+		reason = nil
+	}
+	return []*ast.DirectiveLit{d.directive("deprecated", "reason", reason, dep.off)}
+}
+
+// directive returns the directive @name(arg: val), or @name if val is nil,
+// positioned at the JSON value at off which it's derived from.
+//
+func (d *decoder) directive(name, arg string, val *string, off int) *ast.DirectiveLit {
+	pos := d.pos(off)
+	dir := &ast.DirectiveLit{AtPos: pos, Name: name}
+	if val == nil {
+		return dir
+	}
+
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(*val) // a JSON string is a valid GraphQL string
+
+	dir.Args = &ast.CallExpr{
+		Lparen: pos,
+		Args: []*ast.Arg{{
+			Name: &ast.Ident{NamePos: pos, Name: arg},
+			Value: &ast.Arg_BasicLit{BasicLit: &ast.BasicLit{
+				ValuePos: pos,
+				Kind:     token.STRING,
+				Value:    strings.TrimSuffix(b.String(), "\n"),
+			}},
+		}},
+		Rparen: pos,
+	}
+	return dir
+}
+
+// The following methods decode JSON values. Each one expects the value
+// to be next, skipping any whitespace preceding it, and consumes it.
+
+// next skips whitespace and returns the offset of the next value.
+func (d *decoder) next() int {
+	for ; d.off < len(d.src); d.off++ {
+		switch d.src[d.off] {
+		case ' ', '\t', '\n', '\r':
+		default:
+			return d.off
+		}
+	}
+	return d.off
+}
+
+// describe returns a human readable description of the next value.
+func (d *decoder) describe() string {
+	if d.next() == len(d.src) {
+		return "EOF"
+	}
+
+	switch c := d.src[d.off]; {
+	case c == '{':
+		return "object"
+	case c == '[':
+		return "array"
+	case c == '"':
+		return "string"
+	case c == 't' || c == 'f':
+		return "boolean"
+	case c == 'n':
+		return "null"
+	case c == '-' || '0' <= c && c <= '9':
+		return "number"
+	default:
+		return fmt.Sprintf("%q", c)
+	}
+}
+
+// peek reports whether the next value starts with c.
+func (d *decoder) peek(c byte) bool {
+	return d.next() < len(d.src) && d.src[d.off] == c
+}
+
+// literalName consumes the literal name i.e. true, false or null.
+func (d *decoder) literalName(name string) {
+	if !bytes.HasPrefix(d.src[d.next():], []byte(name)) {
+		d.errorf(d.off, ErrBadIntrospection, "invalid literal, expected %s", name)
+	}
+	d.off += len(name)
+}
+
+// null consumes null, if it's next, and reports whether it was.
+func (d *decoder) null() bool {
+	if !d.peek('n') {
+		return false
+	}
+	d.literalName("null")
+	return true
+}
+
+// object decodes an object, calling f to decode the value of each key.
+// It returns the offset of the object.
+//
+func (d *decoder) object(what string, f func(key string)) int {
+	if !d.peek('{') {
+		d.expected(what)
+	}
+	off := d.off
+	d.off++
+
+	if d.peek('}') {
+		d.off++
+		return off
+	}
+	for {
+		key := d.string("key string")
+		if !d.peek(':') {
+			d.expected("':' after key")
+		}
+		d.off++
+
+		f(key)
+
+		switch {
+		case d.peek(','):
+			d.off++
+		case d.peek('}'):
+			d.off++
+			return off
+		default:
+			d.expected("',' or '}' in " + what)
+		}
+	}
+}
+
+// optArray decodes an array, which may be null, calling f to decode each
+// element. It returns the offsets of its brackets, if it isn't null.
+//
+func (d *decoder) optArray(what string, f func()) (opening, closing int) {
+	if d.null() {
+		return
+	}
+	return d.array(what, f)
+}
+
+// array decodes an array, calling f to decode each element.
+// It returns the offsets of its brackets.
+//
+func (d *decoder) array(what string, f func()) (opening, closing int) {
+	if !d.peek('[') {
+		d.expected(what)
+	}
+	opening = d.off
+	d.off++
+
+	if d.peek(']') {
+		closing = d.off
+		d.off++
+		return
+	}
+	for {
+		f()
+
+		switch {
+		case d.peek(','):
+			d.off++
+		case d.peek(']'):
+			closing = d.off
+			d.off++
+			return
+		default:
+			d.expected("',' or ']' in " + what)
+		}
+	}
+}
+
+// optString decodes a string, which may be null.
+func (d *decoder) optString(what string) *string {
+	if d.null() {
+		return nil
+	}
+	s := d.string(what)
+	return &s
+}
+
+// string decodes a string.
+func (d *decoder) string(what string) string {
+	if !d.peek('"') {
+		d.expected(what)
+	}
+	start := d.off
+
+	escaped := false
+	for d.off++; ; d.off++ {
+		if d.off >= len(d.src) {
+			d.errorf(start, ErrBadIntrospection, "unterminated string")
+		}
+
+		c := d.src[d.off]
+		if c == '"' {
+			break
+		}
+		if c == '\\' {
+			escaped = true
+			d.off++
+			continue
+		}
+		if c < ' ' {
+			d.errorf(d.off, ErrBadIntrospection, "invalid character %q in string", c)
+		}
+	}
+	d.off++
+
+	raw := d.src[start:d.off]
+	if !escaped && utf8.Valid(raw) {
+		return string(raw[1 : len(raw)-1])
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		d.errorf(start, ErrBadIntrospection, "malformed string: %s", err)
+	}
+	return s
+}
+
+// boolean decodes a boolean. Null is decoded as false.
+func (d *decoder) boolean(what string) bool {
+	switch {
+	case d.peek('t'):
+		d.literalName("true")
+		return true
+	case d.peek('f'):
+		d.literalName("false")
+	case !d.null():
+		d.expected(what)
+	}
+	return false
+}
+
+// skip skips the next value.
+func (d *decoder) skip() {
+	switch {
+	case d.peek('{'):
+		d.object("object", func(string) { d.skip() })
+	case d.peek('['):
+		d.array("array", d.skip)
+	case d.peek('"'):
+		d.string("string")
+	case d.peek('t'):
+		d.literalName("true")
+	case d.peek('f'):
+		d.literalName("false")
+	case d.peek('n'):
+		d.literalName("null")
+	default:
+		start := d.off
+		for ; d.off < len(d.src) && strings.IndexByte("+-.0123456789Ee", d.src[d.off]) >= 0; d.off++ {
+		}
+		if d.off == start {
+			d.expected("value")
+		}
+	}
+}
@@ -1,9 +1,12 @@
 package parser
 
 import (
+	"encoding/json"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/gqlc/graphql/ast"
 	"github.com/gqlc/graphql/token"
 )
 
@@ -85,6 +88,89 @@ func TestParseIntrospection(t *testing.T) {
 			}
       `,
 		},
+		{
+			Name: "InterfaceWithInterfaces",
+			Src: `interface Test implements A & B {
+	a: A
+}`,
+			Intro: `
+			{
+				"__schema": {
+					"directives": [],
+					"types": [
+						{
+							"kind": "INTERFACE",
+							"name": "Test",
+							"description": null,
+							"fields": [
+								{
+									"name": "a",
+									"description": null,
+									"args": [],
+									"type": {
+										"kind": "OBJECT",
+										"name": "A"
+									},
+									"isDeprecated": false,
+									"deprecationReason": null
+								}
+							],
+							"interfaces": [
+								{
+									"name": "A"
+								},
+								{
+									"name": "B"
+								}
+							],
+							"possibleTypes": null,
+							"enumValues": null,
+							"inputFields": null,
+							"ofType": null
+						}
+					]
+				}
+			}
+			`,
+		},
+		{
+			Name: "ObjectWithoutInterfaces",
+			Src: `type Test {
+	a: A
+}`,
+			Intro: `
+			{
+				"__schema": {
+					"directives": [],
+					"types": [
+						{
+							"kind": "OBJECT",
+							"name": "Test",
+							"description": null,
+							"fields": [
+								{
+									"name": "a",
+									"description": null,
+									"args": [],
+									"type": {
+										"kind": "OBJECT",
+										"name": "A"
+									},
+									"isDeprecated": false,
+									"deprecationReason": null
+								}
+							],
+							"interfaces": [],
+							"possibleTypes": null,
+							"enumValues": null,
+							"inputFields": null,
+							"ofType": null
+						}
+					]
+				}
+			}
+			`,
+		},
 		{
 			Name: "Object",
 			Src: `type Test implements A & B & C {
@@ -489,6 +575,248 @@ func TestParseIntrospection(t *testing.T) {
 			}
 			`,
 		},
+		{
+			Name: "Repeatable Directive",
+			Src:  `directive @test repeatable on OBJECT | INTERFACE`,
+			Intro: `
+			{
+				"__schema": {
+					"types": [],
+					"directives": [
+						{
+							"description": null,
+							"name": "test",
+							"locations": [
+								"OBJECT",
+								"INTERFACE"
+							],
+							"args": [],
+							"isRepeatable": true
+						}
+					]
+				}
+			}
+			`,
+		},
+		{
+			Name: "Schema",
+			Src: `scalar Query
+
+schema {
+	query: Query
+	mutation: Mutation
+}`,
+			Intro: `
+			{
+				"__schema": {
+					"description": null,
+					"queryType": {
+						"name": "Query"
+					},
+					"subscriptionType": null,
+					"directives": [],
+					"types": [
+						{
+							"kind": "SCALAR",
+							"name": "Query",
+							"description": null,
+							"fields": null,
+							"interfaces": null,
+							"possibleTypes": null,
+							"enumValues": null,
+							"inputFields": null,
+							"ofType": null
+						}
+					],
+					"mutationType": {
+						"name": "Mutation"
+					}
+				}
+			}
+			`,
+		},
+		{
+			Name: "Deprecated Field",
+			Src: `type Test {
+	a(b: B @deprecated): A @deprecated
+	c: C @deprecated(reason: "Use a.")
+}`,
+			Intro: `
+			{
+				"__schema": {
+					"directives": [],
+					"types": [
+						{
+							"kind": "OBJECT",
+							"name": "Test",
+							"description": null,
+							"fields": [
+								{
+									"name": "a",
+									"description": null,
+									"args": [
+										{
+											"name": "b",
+											"description": null,
+											"type": {
+												"kind": "OBJECT",
+												"name": "B",
+												"ofType": null
+											},
+											"defaultValue": null,
+											"isDeprecated": true,
+											"deprecationReason": "No longer supported"
+										}
+									],
+									"isDeprecated": true,
+									"deprecationReason": null,
+									"type": {
+										"kind": "OBJECT",
+										"name": "A",
+										"ofType": null
+									}
+								},
+								{
+									"name": "c",
+									"description": null,
+									"args": [],
+									"type": {
+										"kind": "OBJECT",
+										"name": "C",
+										"ofType": null
+									},
+									"isDeprecated": true,
+									"deprecationReason": "Use a."
+								}
+							],
+							"interfaces": [],
+							"possibleTypes": null,
+							"enumValues": null,
+							"inputFields": null,
+							"ofType": null
+						}
+					]
+				}
+			}
+			`,
+		},
+		{
+			Name: "Deprecated Enum Value",
+			Src: `enum Test {
+	A @deprecated
+	B
+}`,
+			Intro: `
+			{
+				"__schema": {
+					"directives": [],
+					"types": [
+						{
+							"kind": "ENUM",
+							"name": "Test",
+							"description": null,
+							"fields": null,
+							"interfaces": null,
+							"possibleTypes": null,
+							"enumValues": [
+								{
+									"name": "A",
+									"description": null,
+									"isDeprecated": true,
+									"deprecationReason": null
+								},
+								{
+									"name": "B",
+									"description": null,
+									"isDeprecated": false,
+									"deprecationReason": null
+								}
+							],
+							"inputFields": null,
+							"ofType": null
+						}
+					]
+				}
+			}
+			`,
+		},
+		{
+			Name: "Deprecated Input Field",
+			Src: `input Test {
+	a: Int = 1 @deprecated(reason: "Use \"b\".")
+	b: Int
+}`,
+			Intro: `
+			{
+				"__schema": {
+					"directives": [],
+					"types": [
+						{
+							"kind": "INPUT_OBJECT",
+							"name": "Test",
+							"description": null,
+							"fields": null,
+							"interfaces": null,
+							"possibleTypes": null,
+							"enumValues": null,
+							"inputFields": [
+								{
+									"name": "a",
+									"description": null,
+									"deprecationReason": "Use \"b\".",
+									"isDeprecated": true,
+									"type": {
+										"kind": "SCALAR",
+										"name": "Int",
+										"ofType": null
+									},
+									"defaultValue": "1"
+								},
+								{
+									"name": "b",
+									"description": null,
+									"type": {
+										"kind": "SCALAR",
+										"name": "Int",
+										"ofType": null
+									},
+									"defaultValue": null,
+									"isDeprecated": false,
+									"deprecationReason": null
+								}
+							],
+							"ofType": null
+						}
+					]
+				}
+			}
+			`,
+		},
+		{
+			Name: "Specified Scalar",
+			Src:  `scalar Time @specifiedBy(url: "https://example.com/time")`,
+			Intro: `
+			{
+				"__schema": {
+					"directives": [],
+					"types": [
+						{
+							"kind": "SCALAR",
+							"name": "Time",
+							"description": null,
+							"specifiedByURL": "https://example.com/time",
+							"fields": null,
+							"interfaces": null,
+							"possibleTypes": null,
+							"enumValues": null,
+							"inputFields": null,
+							"ofType": null
+						}
+					]
+				}
+			}
+			`,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -517,11 +845,42 @@ func TestParseIntrospection(t *testing.T) {
 				return
 			}
 
-			compare(subT, intro, ex)
+			compareIntro(subT, intro, ex)
 		})
 	}
 }
 
+// compareIntro compares the result of ParseIntrospection to the result of
+// ParseDoc, disregarding the positions of their nodes.
+//
+func compareIntro(t *testing.T, out, ex *ast.Document) {
+	clearPositions(reflect.ValueOf(out))
+	clearPositions(reflect.ValueOf(ex))
+	compare(t, out, ex)
+}
+
+// clearPositions zeroes every position within v, which are its only int64 fields.
+func clearPositions(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			clearPositions(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			clearPositions(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.CanSet() {
+				clearPositions(f)
+			}
+		}
+	case reflect.Int64:
+		v.SetInt(0)
+	}
+}
+
 var intro = `{
 	"__schema": {
 		"directives": [
@@ -832,6 +1191,365 @@ var intro = `{
 	}
 }`
 
+func TestParseIntrospection_SchemaDescription(t *testing.T) {
+	src := `{
+	"__schema": {
+		"queryType": { "name": "Root" },
+		"description": "The schema.",
+		"types": [],
+		"directives": []
+	}
+}`
+
+	doc, err := ParseIntrospection(token.NewDocSet(), "test", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Schema == nil || len(doc.Types) != 1 || doc.Types[0] != doc.Schema {
+		t.Fatalf("expected a schema declaration, got: %v", doc.Types)
+	}
+	if docs := doc.Schema.Doc.GetList(); len(docs) != 1 || docs[0].Text != `"The schema."` {
+		t.Errorf("unexpected schema description: %v", docs)
+	}
+
+	ops := doc.Schema.GetTypeSpec().GetSchema().RootOps.List
+	if len(ops) != 1 || ops[0].Name.Name != "query" || ops[0].GetIdent().Name != "Root" {
+		t.Errorf("unexpected root operations: %v", ops)
+	}
+}
+
+func TestParseIntrospection_Descriptions(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Descr string
+		Text  string
+	}{
+		{Name: "Simple", Descr: "A scalar.", Text: `"A scalar."`},
+		{Name: "Quoted", Descr: `"on" or "off"`, Text: `"\"on\" or \"off\""`},
+		{Name: "Escapes", Descr: "a\tb\\c\u0001", Text: `"a\tb\\c\u0001"`},
+		{Name: "Lines", Descr: "A scalar.\n  Spans \"\"\"lines\"\"\".", Text: "\"\"\"\nA scalar.\n  Spans \\\"\"\"lines\\\"\"\".\n\"\"\""},
+		{Name: "IndentedLines", Descr: "  a\n  b", Text: `"  a\n  b"`},
+		{Name: "BlankLine", Descr: "a\n", Text: `"a\n"`},
+		{Name: "CarriageReturn", Descr: "a\r\nb", Text: `"a\r\nb"`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			descr, err := json.Marshal(testCase.Descr)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			src := `{"__schema": {"types": [{"kind": "SCALAR", "name": "S", "description": ` + string(descr) + `}], "directives": []}}`
+
+			doc, err := ParseIntrospection(token.NewDocSet(), "test", strings.NewReader(src))
+			if err != nil {
+				subT.Fatal(err)
+			}
+			text := doc.Types[0].Doc.List[0].Text
+			if text != testCase.Text {
+				subT.Fatalf("expected text: %s, got: %s", testCase.Text, text)
+			}
+
+			// the text must be a description, as in SDL
+			sdl, err := ParseDoc(token.NewDocSet(), "test", strings.NewReader(text+"\nscalar S"), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if sdlText := sdl.Types[0].Doc.List[0].Text; sdlText != text {
+				subT.Errorf("expected text: %s, got: %s", text, sdlText)
+			}
+		})
+	}
+}
+
+func TestParseIntrospection_Response(t *testing.T) {
+	const scalar = `{
+		"kind": "SCALAR",
+		"name": "Test",
+		"description": null,
+		"specifiedByURL": null,
+		"isOneOf": null,
+		"fields": null,
+		"interfaces": null,
+		"possibleTypes": null,
+		"enumValues": null,
+		"inputFields": null,
+		"ofType": null
+	}`
+
+	testCases := []struct {
+		Name  string
+		Intro string
+		Err   string
+	}{
+		{
+			Name:  "Data",
+			Intro: `{"data": {"__schema": {"types": [` + scalar + `], "directives": []}}}`,
+		},
+		{
+			Name: "UnknownKeys",
+			Intro: `{
+				"extensions": {"tracing": {"version": 1, "execution": {"resolvers": [{"path": ["__schema"]}]}}},
+				"data": {
+					"__schema": {"directives": [], "unknown": [{"a": [1, 2]}], "types": [` + scalar + `]},
+					"other": null
+				},
+				"errors": []
+			}`,
+		},
+		{
+			Name: "Errors",
+			Intro: `{
+				"errors": [
+					{"message": "introspection is disabled", "locations": [{"line": 1, "column": 2}], "path": ["__schema"]},
+					{"message": "unauthorized", "extensions": {"code": "FORBIDDEN"}}
+				],
+				"data": null
+			}`,
+			Err: "response error: __schema: introspection is disabled (and 1 more errors)",
+		},
+		{
+			Name:  "ErrorsWithoutData",
+			Intro: `{"errors": [{"message": "introspection is disabled"}]}`,
+			Err:   "response error: introspection is disabled",
+		},
+		{
+			Name:  "NullDataBeforeErrors",
+			Intro: `{"data": null, "errors": [{"message": "introspection is disabled"}]}`,
+			Err:   "response error: introspection is disabled",
+		},
+		{
+			Name:  "MissingSchema",
+			Intro: `{"data": null}`,
+			Err:   "test:1:1: missing __schema",
+		},
+		{
+			Name:  "Malformed",
+			Intro: `{"__schema": ["types"]}`,
+			Err:   "test:1:14: expected __schema object, found array",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			doc, err := ParseIntrospection(token.NewDocSet(), "test", strings.NewReader(testCase.Intro))
+			if testCase.Err != "" {
+				if err == nil || err.Error() != testCase.Err {
+					subT.Fatalf("expected error: %s, got: %v", testCase.Err, err)
+				}
+				return
+			}
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			ex, err := ParseDoc(token.NewDocSet(), "test", strings.NewReader("scalar Test"), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			compareIntro(subT, doc, ex)
+		})
+	}
+
+	_, err := ParseIntrospection(token.NewDocSet(), "test", strings.NewReader(testCases[2].Intro))
+	errs, ok := err.(ResponseErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("expected 2 response errors, got: %#v", err)
+	}
+	if errs[0].Locations[0] != (Location{Line: 1, Column: 2}) || errs[1].Extensions["code"] != "FORBIDDEN" {
+		t.Errorf("unexpected response errors: %v, %v", errs[0], errs[1])
+	}
+}
+
+func TestParseIntrospection_Deprecation(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Dep  string
+		Src  string
+	}{
+		{Name: "NotDeprecated", Dep: `"isDeprecated": false, "deprecationReason": null`, Src: "a: A"},
+		{Name: "NullReason", Dep: `"isDeprecated": true, "deprecationReason": null`, Src: "a: A @deprecated"},
+		{Name: "DefaultReason", Dep: `"isDeprecated": true, "deprecationReason": "No longer supported"`, Src: "a: A @deprecated"},
+		{Name: "Reason", Dep: `"deprecationReason": "Use b.", "isDeprecated": true`, Src: `a: A @deprecated(reason: "Use b.")`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			intro := `{"__schema": {"directives": [], "types": [{
+				"kind": "OBJECT",
+				"name": "Test",
+				"fields": [{"name": "a", "args": [], "type": {"kind": "OBJECT", "name": "A"}, ` + testCase.Dep + `}],
+				"interfaces": []
+			}]}}`
+
+			doc, err := ParseIntrospection(token.NewDocSet(), "test", strings.NewReader(intro))
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			ex, err := ParseDoc(token.NewDocSet(), "test", strings.NewReader("type Test { "+testCase.Src+" }"), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			compareIntro(subT, doc, ex)
+		})
+	}
+}
+
+func TestParseIntrospection_Positions(t *testing.T) {
+	src := `{"__schema": {
+  "types": [
+    {
+      "kind": "OBJECT",
+      "name": "Query",
+      "description": "The root type.",
+      "fields": [
+        {"name": "a", "args": [{"name": "b", "type": {"kind": "SCALAR", "name": "Int"}, "defaultValue": "[1]"}], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "String"}}, "isDeprecated": true}
+      ]
+    }
+  ],
+  "directives": [{"name": "tag", "locations": ["FIELD"], "args": []}]
+}}`
+
+	dset := token.NewDocSet()
+	doc, err := ParseIntrospection(dset, "test", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj := doc.Types[0].GetTypeSpec().GetObject()
+	field := obj.Fields.List[0]
+	arg := field.Args.List[0]
+	dir := doc.Types[1].GetTypeSpec().GetDirective()
+
+	testCases := []struct {
+		Name string
+		Pos  int64
+		JSON string // the src at Pos
+	}{
+		{Name: "Type", Pos: doc.Types[0].TokPos, JSON: "{\n      \"kind\": \"OBJECT\""},
+		{Name: "TypeName", Pos: doc.Types[0].GetTypeSpec().Name.NamePos, JSON: "{\n      \"kind\": \"OBJECT\""},
+		{Name: "Fields", Pos: obj.Fields.Opening, JSON: "[\n        {\"name\": \"a\""},
+		{Name: "FieldsClosing", Pos: obj.Fields.Closing, JSON: "]\n    }"},
+		{Name: "Field", Pos: field.Name.NamePos, JSON: `{"name": "a"`},
+		{Name: "Args", Pos: field.Args.Opening, JSON: `[{"name": "b"`},
+		{Name: "Arg", Pos: arg.Name.NamePos, JSON: `{"name": "b"`},
+		{Name: "ArgType", Pos: arg.GetIdent().NamePos, JSON: `{"kind": "SCALAR", "name": "Int"}`},
+		{Name: "DefaultValue", Pos: arg.GetCompositeLit().Opening, JSON: `"[1]"`},
+		{Name: "FieldType", Pos: field.GetNonNull().GetIdent().NamePos, JSON: `{"kind": "SCALAR", "name": "String"}`},
+		{Name: "Deprecated", Pos: field.Directives[0].AtPos, JSON: `true}`},
+		{Name: "Directive", Pos: doc.Types[1].TokPos, JSON: `{"name": "tag"`},
+		{Name: "Locations", Pos: dir.OnPos, JSON: `["FIELD"]`},
+		{Name: "Location", Pos: dir.Locs[0].Start, JSON: `"FIELD"`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			pos := dset.Position(token.Pos(testCase.Pos))
+			if pos.Filename != "test" || !strings.HasPrefix(src[pos.Offset:], testCase.JSON) {
+				subT.Errorf("expected position of %s, got: %s at %q", testCase.JSON, pos, src[pos.Offset:])
+			}
+		})
+	}
+
+	if pos := dset.Position(token.Pos(field.Name.NamePos)); pos.Line != 8 || pos.Column != 9 {
+		t.Errorf("expected field at 8:9, got: %s", pos)
+	}
+}
+
+func TestParseIntrospection_Errors(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Intro string
+		Code  ErrorCode
+		Err   string
+	}{
+		{
+			Name: "Empty",
+			Code: ErrBadIntrospection,
+			Err:  "test: expected response object, found EOF",
+		},
+		{
+			Name:  "TrailingData",
+			Intro: `{"__schema": {}} {}`,
+			Code:  ErrBadIntrospection,
+			Err:   "test:1:18: unexpected object after response",
+		},
+		{
+			Name:  "DuplicateSchema",
+			Intro: `{"__schema": {}, "data": {"__schema": {}}}`,
+			Code:  ErrBadIntrospection,
+			Err:   "test:1:39: duplicate __schema",
+		},
+		{
+			Name: "UnknownKind",
+			Intro: `{"__schema": {"types": [
+				{"kind": "LIST", "name": "Test"}
+			]}}`,
+			Code: ErrUnknownType,
+			Err:  "test:2:14: unknown type kind: LIST",
+		},
+		{
+			Name: "MissingName",
+			Intro: `{"__schema": {"types": [
+				{"kind": "SCALAR"}
+			]}}`,
+			Code: ErrBadIntrospection,
+			Err:  "test:2:5: missing name of type",
+		},
+		{
+			Name: "MissingType",
+			Intro: `{"__schema": {"types": [
+				{"kind": "OBJECT", "name": "Test", "fields": [{"name": "a"}]}
+			]}}`,
+			Code: ErrBadIntrospection,
+			Err:  "test:2:51: missing type of field a",
+		},
+		{
+			Name: "InvalidLocation",
+			Intro: `{"__schema": {"directives": [
+				{"name": "test", "locations": ["FIELD", "NOWHERE"]}
+			]}}`,
+			Code: ErrInvalidDirectiveLocation,
+			Err:  "test:2:45: invalid directive location: NOWHERE",
+		},
+		{
+			Name: "InvalidDefaultValue",
+			Intro: `{"__schema": {"directives": [
+				{"name": "test", "locations": ["FIELD"], "args": [{"name": "a", "type": {"name": "Int"}, "defaultValue": "[1"}]}
+			]}}`,
+			Code: ErrBadIntrospection,
+			Err:  `test:2:110: invalid default value "[1": unexpected EOF`,
+		},
+		{
+			Name:  "UnterminatedString",
+			Intro: `{"__schema": {"description": "The schema.}}`,
+			Code:  ErrBadIntrospection,
+			Err:   "test:1:30: unterminated string",
+		},
+		{
+			Name:  "MissingComma",
+			Intro: `{"__schema": {"types": [] "directives": []}}`,
+			Code:  ErrBadIntrospection,
+			Err:   "test:1:27: expected ',' or '}' in __schema object, found string",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			_, err := ParseIntrospection(token.NewDocSet(), "test", strings.NewReader(testCase.Intro))
+			list, ok := err.(ErrorList)
+			if !ok || len(list) != 1 {
+				subT.Fatalf("expected an ErrorList, got: %#v", err)
+			}
+			if list[0].Code != testCase.Code || err.Error() != testCase.Err {
+				subT.Errorf("expected %s error: %s, got: %s error: %s", testCase.Code, testCase.Err, list[0].Code, err)
+			}
+		})
+	}
+}
+
 func TestParseIntrospection_All(t *testing.T) {
 	src := `directive @test(a: Int! = 1, b: [Int] = [1,2,3], c: C = {hello: "world",good: "bye"}) on FIELD_DEFINITION | ENUM_VALUE | INPUT_FIELD_DEFINITION
 
@@ -873,7 +1591,7 @@ input Test {
 		return
 	}
 
-	compare(t, out, ex)
+	compareIntro(t, out, ex)
 }
 
 func BenchmarkParseIntrospection(b *testing.B) {
@@ -2,7 +2,8 @@ package parser
 
 import (
 	"fmt"
-	"runtime"
+	"strconv"
+	"strings"
 
 	"github.com/gqlc/graphql/ast"
 	"github.com/gqlc/graphql/lexer"
@@ -14,9 +15,21 @@ type parser struct {
 	l    lexer.Interface
 	name string
 	line int
+	pos  token.Pos
 	pk   lexer.Item
 	mode Mode
 
+	// comments holds the comments skipped by scan, in ParseComments mode,
+	// until they are returned by nextDoc.
+	//
+	comments []comment
+	end      token.Pos // position of the last character of the last significant token
+
+	// error handling state
+	errors ErrorList
+	depth  int  // nesting depth of braces and parentheses
+	eof    bool // EOF has been received from the lexer
+
 	schema *ast.TypeDecl
 
 	dg, cdg []*ast.DocGroup_Doc
@@ -27,12 +40,57 @@ type parser struct {
 	args, fargs []*ast.InputValue
 }
 
+// A comment is a comment skipped by scan.
+type comment struct {
+	lexer.Item
+	trailing bool // it's on the line of the token before it
+}
+
+// scan retrieves the next significant token from the lexer and
+// tracks the nesting depth for error recovery. Comments are skipped,
+// since they may appear anywhere whitespace may; in ParseComments
+// mode, they are kept for nextDoc.
+//
+func (p *parser) scan() lexer.Item {
+	for {
+		if p.eof {
+			return lexer.Item{Pos: p.pos, Line: p.line, Typ: token.EOF}
+		}
+
+		i := p.l.NextItem()
+		switch i.Typ {
+		case token.COMMENT:
+			if p.mode&ParseComments != 0 {
+				trailing := p.end.IsValid() && p.doc.Line(i.Pos) == p.doc.Line(p.end)
+				p.comments = append(p.comments, comment{Item: i, trailing: trailing})
+			}
+			continue
+		case token.LBRACE, token.LPAREN:
+			p.depth++
+		case token.RBRACE, token.RPAREN:
+			p.depth--
+		case token.ERR:
+			// the lexer resumes at the start of a definition after an error
+			p.depth = 0
+		case token.EOF:
+			p.eof = true
+		}
+
+		p.end = i.Pos
+		if n := len(i.Val); n > 0 {
+			p.end += token.Pos(n - 1)
+		}
+		return i
+	}
+}
+
 // next returns the next token
 func (p *parser) next() (i lexer.Item) {
 	defer func() {
 		if i.Line > p.line {
 			p.line = i.Line
 		}
+		p.pos = i.Pos
 	}()
 
 	if p.pk.Line != -1 {
@@ -40,57 +98,247 @@ func (p *parser) next() (i lexer.Item) {
 		p.pk.Line = -1
 		return
 	}
-	return p.l.NextItem()
+	return p.scan()
 }
 
 // peek peeks the next token
 func (p *parser) peek() lexer.Item {
-	p.pk = p.l.NextItem()
+	p.pk = p.scan()
 	return p.pk
 }
 
+// nextDoc returns the comments preceding the next token, one at a time,
+// and then the token itself. It is used wherever comments are collected
+// as docs i.e. between definitions, fields, arguments and enum values.
+// For a comment, it also reports whether it trails the token before it
+// on the same line, in which case it belongs to the node before it.
+//
+func (p *parser) nextDoc() (item lexer.Item, trailing bool) {
+	if len(p.comments) == 0 && p.pk.Line == -1 {
+		p.pk = p.scan()
+	}
+	if len(p.comments) > 0 {
+		c := p.comments[0]
+		p.comments = p.comments[1:]
+		return c.Item, c.trailing
+	}
+	return p.next(), false
+}
+
+// trail adds the trailing comment to the docs of the node it follows.
+func trail(dg **ast.DocGroup, item lexer.Item) {
+	if *dg == nil {
+		*dg = new(ast.DocGroup)
+	}
+	(*dg).List = append((*dg).List, &ast.DocGroup_Doc{
+		Text:    item.Val,
+		Char:    int64(item.Pos),
+		Comment: true,
+	})
+}
+
 func (p *parser) ignore() { p.pk.Line = -1 }
 
 // expect consumes the next token and guarantees it has the required type.
-func (p *parser) expect(tok token.Token, context string) lexer.Item {
-	i := p.l.NextItem()
+func (p *parser) expect(tok token.Token) lexer.Item {
+	i := p.next()
 	if i.Typ != tok {
-		p.unexpected(i, context)
+		p.unexpected(i, tok)
 	}
 	return i
 }
 
-// errorf formats the error and terminates processing.
-func (p *parser) errorf(format string, args ...interface{}) {
-	format = fmt.Sprintf("parser: %s:%d: %s", p.name, p.line, format)
-	panic(fmt.Errorf(format, args...))
+// bailout is used to unwind the parser after an error has been recorded.
+type bailout struct{}
+
+// errorf formats the error for the given token and terminates processing.
+func (p *parser) errorf(item lexer.Item, code ErrorCode, format string, args ...interface{}) {
+	p.error(&Error{
+		Pos:   item.Pos,
+		Code:  code,
+		Found: item,
+		Msg:   fmt.Sprintf(format, args...),
+	})
 }
 
-// error terminates processing.
-func (p *parser) error(err error) {
-	p.errorf("%s", err)
+// position returns the full position for pos, falling back to
+// just the document name if it is unknown.
+//
+func (p *parser) position(pos token.Pos) (position token.Position) {
+	if p.doc != nil {
+		position = p.doc.Position(pos)
+	}
+	if !position.IsValid() {
+		position.Filename = p.name
+	}
+	return
+}
+
+// error records the error and terminates processing.
+func (p *parser) error(err *Error) {
+	p.record(err)
+	panic(bailout{})
+}
+
+// record records the error, resolving its full position.
+func (p *parser) record(err *Error) {
+	err.Position = p.position(err.Pos)
+	p.errors = append(p.errors, err)
+}
+
+// lexError converts a token.ERR item into an Error.
+func lexError(item lexer.Item) *Error {
+	return &Error{
+		Pos:   item.Pos,
+		Code:  ErrLexical,
+		Found: item,
+		Msg:   item.Val,
+		Lex:   item.Err,
+	}
 }
 
 // unexpected complains about the token and terminates processing.
-func (p *parser) unexpected(token lexer.Item, context string) {
-	p.errorf("unexpected %s in %s", token, context)
+// Any tokens which would have been valid instead may be provided.
+//
+func (p *parser) unexpected(item lexer.Item, expected ...token.Token) {
+	if item.Typ == token.ERR {
+		p.error(lexError(item))
+	}
+
+	code, found := ErrUnexpectedToken, strconv.Quote(item.Val)
+	if item.Typ == token.EOF {
+		code, found = ErrUnexpectedEOF, "EOF"
+	}
+
+	msg := "unexpected " + found
+	if len(expected) > 0 {
+		exp := make([]string, len(expected))
+		for i, tok := range expected {
+			exp[i] = describe(tok)
+		}
+		msg = fmt.Sprintf("expected %s, found %s", strings.Join(exp, " or "), found)
+	}
+
+	p.error(&Error{
+		Pos:      item.Pos,
+		Code:     code,
+		Found:    item,
+		Expected: expected,
+		Msg:      msg,
+	})
 }
 
 // recover is the handler that turns panics into returns from the top level of parse.
 func (p *parser) recover(err *error) {
 	e := recover()
 	if e != nil {
-		if _, ok := e.(runtime.Error); ok {
+		if _, ok := e.(bailout); !ok {
 			panic(e)
 		}
 		if p != nil {
 			p.l.Drain()
 			p.l = nil
 		}
-		*err = e.(error)
+	}
+	*err = p.errors.Err()
+}
+
+// try calls f. In AllErrors mode, it recovers from any error raised
+// by f and then synchronizes the parser with the next token for which
+// top reports true. It reports whether f completed without errors.
+//
+func (p *parser) try(top func(item lexer.Item, lineStart bool) bool, f func()) (ok bool) {
+	if p.mode&AllErrors == 0 {
+		f()
+		return true
+	}
+
+	defer func() {
+		e := recover()
+		if e == nil {
+			return
+		}
+		if _, isBailout := e.(bailout); !isBailout {
+			panic(e)
+		}
+
+		p.dg, p.cdg = p.dg[:0], p.cdg[:0]
+		p.direcs, p.dargs = p.direcs[:0], p.dargs[:0]
+		p.fields, p.args, p.fargs = p.fields[:0], p.args[:0], p.fargs[:0]
+
+		p.sync(top)
+	}()
+
+	f()
+	return true
+}
+
+// sync advances to the next token for which top reports true, given
+// whether the token is the first on its line. The nesting depth is reset
+// there, since a definition always begins at the top level, even if an
+// unclosed brace or parenthesis precedes it. Any lexical errors encountered
+// along the way are recorded.
+//
+func (p *parser) sync(top func(item lexer.Item, lineStart bool) bool) {
+	for {
+		p.comments = p.comments[:0] // only those preceding the definition are kept
+		line := p.line
+		item := p.next()
+		if p.depth < 0 {
+			p.depth = 0
+		}
+
+		switch {
+		case item.Typ == token.EOF:
+			p.pk = item
+			return
+		case item.Typ == token.ERR:
+			p.record(lexError(item))
+		case top(item, item.Line > line):
+			p.depth = 0
+			if item.Typ == token.LBRACE {
+				p.depth = 1 // the brace itself has already been counted
+			}
+			p.pk = item
+			return
+		}
 	}
 }
 
+// atTypeSystemDef reports whether the item begins a type system definition.
+// Within braces or parentheses, only a keyword at the start of a line does,
+// as when the lexer resumes after an error.
+//
+func (p *parser) atTypeSystemDef(item lexer.Item, lineStart bool) bool {
+	switch item.Typ {
+	case token.SCHEMA, token.SCALAR, token.TYPE, token.INTERFACE, token.UNION,
+		token.ENUM, token.INPUT, token.DIRECTIVE, token.EXTEND:
+		return p.depth == 0 || lineStart
+	case token.DESCRIPTION:
+		return p.depth == 0
+	}
+	return false
+}
+
+// atExecutableDef reports whether the item begins an executable definition.
+// Within braces or parentheses, only a keyword or brace at the start of a line
+// does, as when the lexer resumes after an error.
+//
+func (p *parser) atExecutableDef(item lexer.Item, lineStart bool) bool {
+	if item.Typ == token.LBRACE {
+		return p.depth == 1 || lineStart // the brace itself has already been counted
+	}
+	if item.Typ != token.IDENT || p.depth > 0 && !lineStart {
+		return false
+	}
+
+	switch item.Val {
+	case "query", "mutation", "subscription", "fragment":
+		return true
+	}
+	return false
+}
+
 func (p *parser) parse(tokDoc *token.Doc, b []byte, mode Mode) (doc *ast.Document, err error) {
 	defer p.recover(&err)
 	p.l = lexer.Lex(tokDoc, string(b))
@@ -115,92 +363,106 @@ func (p *parser) parse(tokDoc *token.Doc, b []byte, mode Mode) (doc *ast.Documen
 
 func (p *parser) parseDoc(types *[]*ast.TypeDecl, directives *[]*ast.DirectiveLit) (docs []*ast.DocGroup_Doc) {
 	var cdocs []*ast.DocGroup_Doc
+	var last **ast.DocGroup // docs of the preceding definition
 	ts := new(ast.TypeSpec)
 	for {
-		item := p.next()
-		switch {
-		case item.Typ == token.EOF:
+		item, trailing := p.nextDoc()
+		if item.Typ == token.EOF {
+			docs = append(docs, cdocs...)
 			return
-		case item.Typ == token.ERR:
-			p.unexpected(item, "parseDoc")
-		case item.Typ == token.EXTEND:
-			typ := p.next()
-			if !typ.Typ.IsKeyword() {
-				p.unexpected(typ, "parseDef:Extension")
-			}
-
-			ts.Reset()
-			p.parseDef(typ, &cdocs, ts)
-
-			extTs := *ts
-			td := &ast.TypeDecl{
-				TokPos: int64(item.Pos),
-				Tok:    item.Typ,
-				Spec: &ast.TypeDecl_TypeExtSpec{
-					TypeExtSpec: &ast.TypeExtensionSpec{
-						TokPos: int64(typ.Pos),
-						Tok:    typ.Typ,
-						Type:   &extTs,
+		}
+
+		ok := p.try(p.atTypeSystemDef, func() {
+			switch {
+			case item.Typ == token.ERR:
+				p.unexpected(item)
+			case item.Typ == token.EXTEND:
+				typ := p.next()
+				if !typ.Typ.IsKeyword() {
+					p.unexpected(typ, token.SCHEMA, token.SCALAR, token.TYPE, token.INTERFACE, token.UNION, token.ENUM, token.INPUT)
+				}
+
+				ts.Reset()
+				p.parseDef(typ, &cdocs, ts)
+
+				extTs := *ts
+				td := &ast.TypeDecl{
+					TokPos: int64(item.Pos),
+					Tok:    item.Typ,
+					Spec: &ast.TypeDecl_TypeExtSpec{
+						TypeExtSpec: &ast.TypeExtensionSpec{
+							TokPos: int64(typ.Pos),
+							Tok:    typ.Typ,
+							Type:   &extTs,
+						},
 					},
-				},
-			}
-			if dLen := len(cdocs); dLen > 0 {
-				td.Doc = &ast.DocGroup{List: make([]*ast.DocGroup_Doc, dLen)}
-				copy(td.Doc.List, cdocs)
-				cdocs = cdocs[:0]
-			}
+				}
+				if dLen := len(cdocs); dLen > 0 {
+					td.Doc = &ast.DocGroup{List: make([]*ast.DocGroup_Doc, dLen)}
+					copy(td.Doc.List, cdocs)
+					cdocs = cdocs[:0]
+				}
 
-			*types = append(*types, td)
-		case item.Typ.IsKeyword():
-			ts.Reset()
+				*types = append(*types, td)
+				last = &td.Doc
+			case item.Typ.IsKeyword():
+				ts.Reset()
 
-			p.parseDef(item, &cdocs, ts)
+				p.parseDef(item, &cdocs, ts)
 
-			tts := *ts
-			td := &ast.TypeDecl{
-				TokPos: int64(item.Pos),
-				Tok:    item.Typ,
-				Spec:   &ast.TypeDecl_TypeSpec{TypeSpec: &tts},
-			}
-			if dLen := len(cdocs); dLen > 0 {
-				td.Doc = &ast.DocGroup{List: make([]*ast.DocGroup_Doc, dLen)}
-				copy(td.Doc.List, cdocs)
-				cdocs = cdocs[:0]
-			}
+				tts := *ts
+				td := &ast.TypeDecl{
+					TokPos: int64(item.Pos),
+					Tok:    item.Typ,
+					Spec:   &ast.TypeDecl_TypeSpec{TypeSpec: &tts},
+				}
+				if dLen := len(cdocs); dLen > 0 {
+					td.Doc = &ast.DocGroup{List: make([]*ast.DocGroup_Doc, dLen)}
+					copy(td.Doc.List, cdocs)
+					cdocs = cdocs[:0]
+				}
 
-			*types = append(*types, td)
+				*types = append(*types, td)
+				last = &td.Doc
 
-			if item.Typ == token.SCHEMA {
-				p.schema = td
-			}
-		case item.Typ == token.COMMENT && p.mode&ParseComments != 0 || item.Typ == token.DESCRIPTION:
-			d := &ast.DocGroup_Doc{
-				Text:    item.Val,
-				Char:    int64(item.Pos),
-				Comment: item.Typ == token.COMMENT,
-			}
+				if item.Typ == token.SCHEMA {
+					p.schema = td
+				}
+			case item.Typ == token.COMMENT && trailing && last != nil:
+				trail(last, item)
+			case item.Typ == token.COMMENT || item.Typ == token.DESCRIPTION:
+				last = nil
+				d := &ast.DocGroup_Doc{
+					Text:    item.Val,
+					Char:    int64(item.Pos),
+					Comment: item.Typ == token.COMMENT,
+				}
 
-			if len(cdocs) == 0 {
-				cdocs = append(cdocs, d)
-				break
-			}
+				if len(cdocs) == 0 {
+					cdocs = append(cdocs, d)
+					break
+				}
 
-			prev := cdocs[len(cdocs)-1]
-			lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text)))
-			if p.doc.Line(token.Pos(d.Char))-lprev == 1 {
+				prev := cdocs[len(cdocs)-1]
+				lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text) - 1))
+				if p.doc.Line(token.Pos(d.Char))-lprev == 1 {
+					cdocs = append(cdocs, d)
+					break
+				}
+
+				docs = append(docs, cdocs...)
+				cdocs = cdocs[:0]
 				cdocs = append(cdocs, d)
-				break
+			case item.Typ == token.AT:
+				last = nil
+				p.pk = item
+				p.parseDirectives(directives, true)
+			default:
+				p.unexpected(item)
 			}
-
-			docs = append(docs, cdocs...)
-			cdocs = cdocs[:0]
-			cdocs = append(cdocs, d)
-		case item.Typ == token.AT:
-			p.pk = item
-			p.parseDirectives(directives)
-		case item.Typ == token.COMMENT:
-		default:
-			p.unexpected(item, "parseDoc:UnknownToken")
+		})
+		if !ok {
+			cdocs, last = cdocs[:0], nil
 		}
 	}
 }
@@ -224,15 +486,18 @@ func (p *parser) parseDef(item lexer.Item, docs *[]*ast.DocGroup_Doc, ts *ast.Ty
 	case token.SCHEMA:
 		p.parseSchema(item.Pos, item.Line, docs, ts)
 	default:
-		p.errorf("unknown type")
+		p.errorf(item, ErrUnknownType, "unknown type: %s", item.Val)
 	}
 }
 
-func (p *parser) parseDirectives(directives *[]*ast.DirectiveLit) {
+// parseDirectives parses applied directives, whose arguments must be
+// constant if isConst is set.
+//
+func (p *parser) parseDirectives(directives *[]*ast.DirectiveLit, isConst bool) {
 	for {
 		item := p.next() // This should always be served out of p.pk
 		if item.Typ == token.ERR {
-			p.unexpected(item, "parseDirectives")
+			p.unexpected(item)
 		}
 		if item.Typ == token.EOF {
 			*directives = append(*directives, p.direcs...)
@@ -241,7 +506,7 @@ func (p *parser) parseDirectives(directives *[]*ast.DirectiveLit) {
 			return
 		}
 
-		name := p.expect(token.IDENT, "parseDirectives:MustHaveName")
+		name := p.expect(token.IDENT)
 
 		dir := &ast.DirectiveLit{
 			AtPos: int64(item.Pos),
@@ -251,51 +516,13 @@ func (p *parser) parseDirectives(directives *[]*ast.DirectiveLit) {
 
 		item = p.peek()
 		if item.Typ == token.LPAREN {
-			dir.Args = &ast.CallExpr{
-				Lparen: int64(item.Pos),
-			}
 			p.ignore()
-
-			for {
-				item = p.next()
-				if item.Typ == token.ERR || item.Typ == token.EOF {
-					p.unexpected(item, "parseDirectives:MalformedArg")
-				}
-				if item.Typ == token.RPAREN {
-					dir.Args.Args = append(dir.Args.Args, p.dargs...)
-					p.dargs = p.dargs[:0]
-					break
-				}
-				if item.Typ == token.COMMENT && p.mode&ParseComments != 0 {
-					continue // TODO
-				}
-
-				if item.Typ != token.IDENT {
-					p.unexpected(item, "parseDirectives:MissingArgName")
-				}
-
-				arg := &ast.Arg{
-					Name: &ast.Ident{NamePos: int64(item.Pos), Name: item.Val},
-				}
-				p.expect(token.COLON, "parseDirectives:MissingColon")
-
-				val := p.parseValue()
-				switch v := val.(type) {
-				case *ast.BasicLit:
-					arg.Value = &ast.Arg_BasicLit{BasicLit: v}
-				case *ast.CompositeLit:
-					arg.Value = &ast.Arg_CompositeLit{CompositeLit: v}
-				}
-
-				p.dargs = append(p.dargs, arg)
-			}
-
-			dir.Args.Rparen = int64(item.Pos)
+			dir.Args = p.parseArgs(item, isConst)
 
 			item = p.peek()
 		}
 
-		if item.Typ != token.AT || item.Line != p.line { // Enforce directives being on the same line
+		if item.Typ != token.AT {
 			*directives = append(*directives, p.direcs...)
 			p.direcs = p.direcs[:0]
 			return
@@ -303,8 +530,51 @@ func (p *parser) parseDirectives(directives *[]*ast.DirectiveLit) {
 	}
 }
 
+// parseArgs parses the arguments of an applied directive or selected field.
+// The opening parenthesis must have already been consumed. If isConst is set,
+// the values may not reference variables.
+//
+func (p *parser) parseArgs(lparen lexer.Item, isConst bool) *ast.CallExpr {
+	call := &ast.CallExpr{
+		Lparen: int64(lparen.Pos),
+	}
+
+	for {
+		item := p.next()
+		if item.Typ == token.ERR || item.Typ == token.EOF {
+			p.unexpected(item, token.IDENT, token.RPAREN)
+		}
+		if item.Typ == token.RPAREN {
+			call.Args = append(call.Args, p.dargs...)
+			p.dargs = p.dargs[:0]
+			call.Rparen = int64(item.Pos)
+			return call
+		}
+		if item.Typ != token.IDENT && !item.Typ.IsKeyword() {
+			p.unexpected(item, token.IDENT, token.RPAREN)
+		}
+
+		arg := &ast.Arg{
+			Name: &ast.Ident{NamePos: int64(item.Pos), Name: item.Val},
+		}
+		p.expect(token.COLON)
+
+		val := p.parseValue(isConst)
+		switch v := val.(type) {
+		case *ast.BasicLit:
+			arg.Value = &ast.Arg_BasicLit{BasicLit: v}
+		case *ast.CompositeLit:
+			arg.Value = &ast.Arg_CompositeLit{CompositeLit: v}
+		case *ast.Variable:
+			arg.Value = &ast.Arg_Variable{Variable: v}
+		}
+
+		p.dargs = append(p.dargs, arg)
+	}
+}
+
 func (p *parser) parseObject(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, ts *ast.TypeSpec) {
-	name := p.expect(token.IDENT, "parseObject:MustHaveName")
+	name := p.expect(token.IDENT)
 
 	ts.Name = &ast.Ident{
 		NamePos: int64(name.Pos),
@@ -315,27 +585,9 @@ func (p *parser) parseObject(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 	}
 	ts.Type = &ast.TypeSpec_Object{Object: obj}
 
-	item := p.peek()
-	if item.Typ == token.IMPLEMENTS {
-		p.ignore()
-		obj.ImplPos = int64(item.Pos)
-
-		for {
-			item = p.peek()
-			if item.Typ != token.IDENT && item.Typ != token.AND {
-				break
-			}
-			if item.Typ == token.AND {
-				p.ignore()
-				continue
-			}
-
-			obj.Interfaces = append(obj.Interfaces, &ast.Ident{NamePos: int64(item.Pos), Name: item.Val})
-		}
-	}
-
+	item := p.parseImplements(&obj.ImplPos, &obj.Interfaces)
 	if item.Typ == token.AT {
-		p.parseDirectives(&ts.Directives)
+		p.parseDirectives(&ts.Directives, true)
 		item = p.pk
 	}
 
@@ -351,8 +603,33 @@ func (p *parser) parseObject(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 	obj.Fields.Closing = p.parseFields(docs, &obj.Fields.List)
 }
 
+// parseImplements parses the list of implemented interfaces, if any,
+// and returns the token following it.
+//
+func (p *parser) parseImplements(implPos *int64, interfaces *[]*ast.Ident) lexer.Item {
+	item := p.peek()
+	if item.Typ != token.IMPLEMENTS {
+		return item
+	}
+	p.ignore()
+	*implPos = int64(item.Pos)
+
+	for {
+		item = p.peek()
+		if item.Typ != token.IDENT && item.Typ != token.AND {
+			return item
+		}
+		if item.Typ == token.AND {
+			p.ignore()
+			continue
+		}
+
+		*interfaces = append(*interfaces, &ast.Ident{NamePos: int64(item.Pos), Name: item.Val})
+	}
+}
+
 func (p *parser) parseInput(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, ts *ast.TypeSpec) {
-	name := p.expect(token.IDENT, "parseInput:MustHaveName")
+	name := p.expect(token.IDENT)
 
 	ts.Name = &ast.Ident{
 		NamePos: int64(name.Pos),
@@ -365,7 +642,7 @@ func (p *parser) parseInput(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 
 	item := p.peek()
 	if item.Typ == token.AT {
-		p.parseDirectives(&ts.Directives)
+		p.parseDirectives(&ts.Directives, true)
 		item = p.pk
 	}
 
@@ -381,7 +658,7 @@ func (p *parser) parseInput(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 }
 
 func (p *parser) parseInterface(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, ts *ast.TypeSpec) {
-	name := p.expect(token.IDENT, "parseInterface:MustHaveName")
+	name := p.expect(token.IDENT)
 
 	ts.Name = &ast.Ident{
 		NamePos: int64(name.Pos),
@@ -392,9 +669,9 @@ func (p *parser) parseInterface(pos token.Pos, line int, docs *[]*ast.DocGroup_D
 	}
 	ts.Type = &ast.TypeSpec_Interface{Interface: inter}
 
-	item := p.peek()
+	item := p.parseImplements(&inter.ImplPos, &inter.Interfaces)
 	if item.Typ == token.AT {
-		p.parseDirectives(&ts.Directives)
+		p.parseDirectives(&ts.Directives, true)
 		item = p.pk
 	}
 
@@ -410,7 +687,7 @@ func (p *parser) parseInterface(pos token.Pos, line int, docs *[]*ast.DocGroup_D
 }
 
 func (p *parser) parseUnion(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, ts *ast.TypeSpec) {
-	name := p.expect(token.IDENT, "parseUnion:MustHaveName")
+	name := p.expect(token.IDENT)
 
 	ts.Name = &ast.Ident{
 		NamePos: int64(name.Pos),
@@ -423,7 +700,7 @@ func (p *parser) parseUnion(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 
 	item := p.peek()
 	if item.Typ == token.AT {
-		p.parseDirectives(&ts.Directives)
+		p.parseDirectives(&ts.Directives, true)
 		item = p.pk
 	}
 
@@ -446,7 +723,7 @@ func (p *parser) parseUnion(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 }
 
 func (p *parser) parseEnum(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, ts *ast.TypeSpec) {
-	name := p.expect(token.IDENT, "parseEnum:MustHaveName")
+	name := p.expect(token.IDENT)
 
 	ts.Name = &ast.Ident{
 		NamePos: int64(name.Pos),
@@ -459,7 +736,7 @@ func (p *parser) parseEnum(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, t
 
 	item := p.peek()
 	if item.Typ == token.AT {
-		p.parseDirectives(&ts.Directives)
+		p.parseDirectives(&ts.Directives, true)
 		item = p.pk
 	}
 
@@ -475,7 +752,7 @@ func (p *parser) parseEnum(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, t
 }
 
 func (p *parser) parseScalar(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, ts *ast.TypeSpec) {
-	name := p.expect(token.IDENT, "parseScalar:MustHaveName")
+	name := p.expect(token.IDENT)
 
 	ts.Name = &ast.Ident{
 		NamePos: int64(name.Pos),
@@ -490,16 +767,16 @@ func (p *parser) parseScalar(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 	}
 
 	item := p.peek()
-	if item.Typ == token.AT && item.Line == line {
-		p.parseDirectives(&ts.Directives)
+	if item.Typ == token.AT {
+		p.parseDirectives(&ts.Directives, true)
 	}
 }
 
 func (p *parser) parseDirective(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc, ts *ast.TypeSpec) {
-	p.expect(token.AT, "parseDirective")
+	p.expect(token.AT)
 	name := p.next()
 	if name.Typ != token.IDENT && !name.Typ.IsKeyword() {
-		p.unexpected(name, "parseDirective:MustHaveName")
+		p.unexpected(name, token.IDENT)
 	}
 
 	ts.Name = &ast.Ident{
@@ -520,8 +797,15 @@ func (p *parser) parseDirective(pos token.Pos, line int, docs *[]*ast.DocGroup_D
 		item = p.next()
 	}
 
+	// repeatable is only a keyword here, so it can still be used as a name
+	if item.Typ == token.IDENT && item.Val == "repeatable" {
+		directive.Repeatable = true
+		directive.RepeatablePos = int64(item.Pos)
+		item = p.next()
+	}
+
 	if item.Typ != token.ON {
-		p.unexpected(item, "parseDirective:MissingOnKeyword")
+		p.unexpected(item, token.REPEATABLE, token.ON)
 	}
 	directive.OnPos = int64(item.Pos)
 
@@ -536,7 +820,7 @@ func (p *parser) parseDirective(pos token.Pos, line int, docs *[]*ast.DocGroup_D
 
 		loc, valid := ast.DirectiveLocation_Loc_value[item.Val]
 		if !valid {
-			p.errorf("invalid directive location: %s", item.Val)
+			p.errorf(item, ErrInvalidDirectiveLocation, "invalid directive location: %s", item.Val)
 		}
 
 		directive.Locs = append(directive.Locs, &ast.DirectiveLocation{
@@ -554,7 +838,7 @@ func (p *parser) parseSchema(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 
 	item := p.peek()
 	if item.Typ == token.AT {
-		p.parseDirectives(&ts.Directives)
+		p.parseDirectives(&ts.Directives, true)
 		item = p.pk
 	}
 
@@ -570,8 +854,9 @@ func (p *parser) parseSchema(pos token.Pos, line int, docs *[]*ast.DocGroup_Doc,
 }
 
 func (p *parser) parseFields(docs *[]*ast.DocGroup_Doc, fields *[]*ast.Field) int64 {
+	var last **ast.DocGroup // docs of the preceding field
 	for {
-		item := p.next()
+		item, trailing := p.nextDoc()
 		switch {
 		case item.Typ == token.RBRACE:
 			*docs = append(*docs, p.dg...)
@@ -585,6 +870,7 @@ func (p *parser) parseFields(docs *[]*ast.DocGroup_Doc, fields *[]*ast.Field) in
 				Name: &ast.Ident{NamePos: int64(item.Pos), Name: item.Val},
 			}
 			p.fields = append(p.fields, f)
+			last = &f.Doc
 
 			item = p.peek()
 			if item.Typ == token.LPAREN {
@@ -603,7 +889,7 @@ func (p *parser) parseFields(docs *[]*ast.DocGroup_Doc, fields *[]*ast.Field) in
 				item = p.peek()
 			}
 			if item.Typ != token.COLON {
-				p.unexpected(item, "parseFields:ExpectedColon")
+				p.unexpected(item, token.COLON)
 			}
 			p.ignore()
 
@@ -627,8 +913,11 @@ func (p *parser) parseFields(docs *[]*ast.DocGroup_Doc, fields *[]*ast.Field) in
 			if p.pk.Typ != token.AT {
 				break
 			}
-			p.parseDirectives(&f.Directives)
-		case item.Typ == token.COMMENT && p.mode&ParseComments != 0 || item.Typ == token.DESCRIPTION:
+			p.parseDirectives(&f.Directives, true)
+		case item.Typ == token.COMMENT && trailing && last != nil:
+			trail(last, item)
+		case item.Typ == token.COMMENT || item.Typ == token.DESCRIPTION:
+			last = nil
 			d := &ast.DocGroup_Doc{
 				Text:    item.Val,
 				Char:    int64(item.Pos),
@@ -641,7 +930,7 @@ func (p *parser) parseFields(docs *[]*ast.DocGroup_Doc, fields *[]*ast.Field) in
 			}
 
 			prev := p.dg[len(p.dg)-1]
-			lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text)))
+			lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text) - 1))
 			if p.doc.Line(token.Pos(d.Char))-lprev == 1 {
 				p.dg = append(p.dg, d)
 				break
@@ -651,14 +940,15 @@ func (p *parser) parseFields(docs *[]*ast.DocGroup_Doc, fields *[]*ast.Field) in
 			p.dg = p.dg[:0]
 			p.dg = append(p.dg, d)
 		default:
-			p.unexpected(item, "parseFields")
+			p.unexpected(item, token.IDENT, token.RBRACE)
 		}
 	}
 }
 
 func (p *parser) parseArgDefs(docs *[]*ast.DocGroup_Doc, args *[]*ast.InputValue) int64 {
+	var last **ast.DocGroup // docs of the preceding argument
 	for {
-		item := p.next()
+		item, trailing := p.nextDoc()
 		switch {
 		case item.Typ == token.RPAREN || item.Typ == token.RBRACE:
 			*docs = append(*docs, p.cdg...)
@@ -678,8 +968,9 @@ func (p *parser) parseArgDefs(docs *[]*ast.DocGroup_Doc, args *[]*ast.InputValue
 				copy(arg.Doc.List, p.cdg)
 				p.cdg = p.cdg[:0]
 			}
+			last = &arg.Doc
 
-			p.expect(token.COLON, "parseArgDefs:ExpectedColon")
+			p.expect(token.COLON)
 
 			typ := p.parseType()
 			switch v := typ.(type) {
@@ -695,7 +986,7 @@ func (p *parser) parseArgDefs(docs *[]*ast.DocGroup_Doc, args *[]*ast.InputValue
 			if p.pk.Typ == token.ASSIGN {
 				p.ignore()
 
-				val := p.parseValue()
+				val := p.parseValue(true)
 				switch v := val.(type) {
 				case *ast.BasicLit:
 					arg.Default = &ast.InputValue_BasicLit{BasicLit: v}
@@ -709,8 +1000,11 @@ func (p *parser) parseArgDefs(docs *[]*ast.DocGroup_Doc, args *[]*ast.InputValue
 			if p.pk.Typ != token.AT {
 				break
 			}
-			p.parseDirectives(&arg.Directives)
-		case item.Typ == token.COMMENT && p.mode&ParseComments != 0 || item.Typ == token.DESCRIPTION:
+			p.parseDirectives(&arg.Directives, true)
+		case item.Typ == token.COMMENT && trailing && last != nil:
+			trail(last, item)
+		case item.Typ == token.COMMENT || item.Typ == token.DESCRIPTION:
+			last = nil
 			d := &ast.DocGroup_Doc{
 				Text:    item.Val,
 				Char:    int64(item.Pos),
@@ -723,7 +1017,7 @@ func (p *parser) parseArgDefs(docs *[]*ast.DocGroup_Doc, args *[]*ast.InputValue
 			}
 
 			prev := p.cdg[len(p.cdg)-1]
-			lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text)))
+			lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text) - 1))
 			if p.doc.Line(token.Pos(d.Char))-lprev == 1 {
 				p.cdg = append(p.cdg, d)
 				break
@@ -733,14 +1027,15 @@ func (p *parser) parseArgDefs(docs *[]*ast.DocGroup_Doc, args *[]*ast.InputValue
 			p.cdg = p.cdg[:0]
 			p.cdg = append(p.cdg, d)
 		default:
-			p.unexpected(item, "parseArgDefs")
+			p.unexpected(item, token.IDENT, token.RPAREN)
 		}
 	}
 }
 
 func (p *parser) parseEnumValues(docs *[]*ast.DocGroup_Doc, values *[]*ast.Field) int64 {
+	var last **ast.DocGroup // docs of the preceding value
 	for {
-		item := p.next()
+		item, trailing := p.nextDoc()
 		switch {
 		case item.Typ == token.RBRACE:
 			*docs = append(*docs, p.dg...)
@@ -760,12 +1055,16 @@ func (p *parser) parseEnumValues(docs *[]*ast.DocGroup_Doc, values *[]*ast.Field
 				copy(f.Doc.List, p.dg)
 				p.dg = p.dg[:0]
 			}
+			last = &f.Doc
 
 			item = p.peek()
 			if item.Typ == token.AT {
-				p.parseDirectives(&f.Directives)
+				p.parseDirectives(&f.Directives, true)
 			}
-		case item.Typ == token.COMMENT && p.mode&ParseComments != 0 || item.Typ == token.DESCRIPTION:
+		case item.Typ == token.COMMENT && trailing && last != nil:
+			trail(last, item)
+		case item.Typ == token.COMMENT || item.Typ == token.DESCRIPTION:
+			last = nil
 			d := &ast.DocGroup_Doc{
 				Text:    item.Val,
 				Char:    int64(item.Pos),
@@ -778,7 +1077,7 @@ func (p *parser) parseEnumValues(docs *[]*ast.DocGroup_Doc, values *[]*ast.Field
 			}
 
 			prev := p.dg[len(p.dg)-1]
-			lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text)))
+			lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text) - 1))
 			if p.doc.Line(token.Pos(d.Char))-lprev == 1 {
 				p.dg = append(p.dg, d)
 				break
@@ -788,7 +1087,7 @@ func (p *parser) parseEnumValues(docs *[]*ast.DocGroup_Doc, values *[]*ast.Field
 			p.dg = p.dg[:0]
 			p.dg = append(p.dg, d)
 		default:
-			p.unexpected(item, "parseEnumValues")
+			p.unexpected(item, token.IDENT, token.RBRACE)
 		}
 	}
 }
@@ -823,7 +1122,7 @@ func (p *parser) parseType() interface{} {
 
 		item = p.next()
 		if item.Typ != token.RBRACK {
-			p.unexpected(item, "parseType:MissingListRBrack")
+			p.unexpected(item, token.RBRACK)
 		}
 
 		item = p.peek()
@@ -836,17 +1135,33 @@ func (p *parser) parseType() interface{} {
 			Type: &ast.NonNull_List{List: v},
 		}
 	default:
-		p.unexpected(item, "parseType")
+		p.unexpected(item, token.IDENT, token.LBRACK)
 	}
 	return nil
 }
 
-func (p *parser) parseValue() interface{} {
+// parseValue parses a value. If isConst is set, as it is everywhere except
+// in the arguments of an executable document, variables are rejected at any depth.
+//
+func (p *parser) parseValue(isConst bool) interface{} {
 	item := p.next()
 
 	switch item.Typ {
 	case token.INT, token.FLOAT, token.STRING, token.BOOL, token.NULL, token.IDENT:
 		return &ast.BasicLit{Kind: item.Typ, ValuePos: int64(item.Pos), Value: item.Val}
+	case token.VAR:
+		if isConst {
+			p.errorf(item, ErrNonConstantValue, "variables are not allowed in constant values")
+		}
+		name := p.next()
+		if name.Typ != token.IDENT && !name.Typ.IsKeyword() {
+			p.unexpected(name, token.IDENT)
+		}
+
+		return &ast.Variable{
+			Dollar: int64(item.Pos),
+			Name:   &ast.Ident{NamePos: int64(name.Pos), Name: name.Val},
+		}
 	case token.LBRACK:
 		list := &ast.ListLit_Composite{}
 
@@ -865,12 +1180,14 @@ func (p *parser) parseValue() interface{} {
 				return v
 			}
 
-			el := p.parseValue()
+			el := p.parseValue(isConst)
 			switch e := el.(type) {
 			case *ast.BasicLit:
 				c = &ast.CompositeLit{Value: &ast.CompositeLit_BasicLit{BasicLit: e}}
 			case *ast.CompositeLit:
 				c = e
+			case *ast.Variable:
+				c = &ast.CompositeLit{Value: &ast.CompositeLit_Variable{Variable: e}}
 			}
 
 			list.Values = append(list.Values, c)
@@ -888,24 +1205,359 @@ func (p *parser) parseValue() interface{} {
 				v.Closing = int64(item.Pos)
 				return v
 			}
-			if item.Typ != token.IDENT {
-				p.unexpected(item, "parseValue:InvalidObjectKey")
+			if item.Typ != token.IDENT && !item.Typ.IsKeyword() && item.Typ != token.BOOL && item.Typ != token.NULL {
+				p.unexpected(item, token.IDENT, token.RBRACE)
 			}
 
-			pair := &ast.ObjLit_Pair{Key: &ast.Ident{NamePos: int64(item.Pos), Name: item.Val}}
+			pair := &ast.ObjLit_Pair{Key: p.expectNameFrom(item)}
 			objLit.Fields = append(objLit.Fields, pair)
-			p.expect(token.COLON, "parseValue:MissingColonInObjField")
+			p.expect(token.COLON)
 
-			val := p.parseValue()
+			val := p.parseValue(isConst)
 			switch ov := val.(type) {
 			case *ast.BasicLit:
 				pair.Val = &ast.CompositeLit{Value: &ast.CompositeLit_BasicLit{BasicLit: ov}}
 			case *ast.CompositeLit:
 				pair.Val = ov
+			case *ast.Variable:
+				pair.Val = &ast.CompositeLit{Value: &ast.CompositeLit_Variable{Variable: ov}}
 			}
 		}
 	default:
-		p.unexpected(item, "parseValue")
+		p.unexpected(item)
 	}
 	return nil
 }
+
+func (p *parser) parseQuery(tokDoc *token.Doc, b []byte, mode Mode) (doc *ast.ExecutableDocument, err error) {
+	defer p.recover(&err)
+	p.l = lexer.LexQuery(tokDoc, string(b))
+	p.doc = tokDoc
+	p.mode = mode
+
+	doc = &ast.ExecutableDocument{
+		Name: p.name,
+	}
+	docs := p.parseExecutableDefs(&doc.Definitions)
+	if len(docs) > 0 {
+		doc.Doc = &ast.DocGroup{
+			List: docs,
+		}
+	}
+	return
+}
+
+// expectName consumes the next token and guarantees it is a valid GraphQL name.
+func (p *parser) expectName() *ast.Ident {
+	return p.expectNameFrom(p.next())
+}
+
+// expectNameFrom guarantees the given token is a valid GraphQL name.
+func (p *parser) expectNameFrom(item lexer.Item) *ast.Ident {
+	if item.Typ != token.IDENT && !item.Typ.IsKeyword() && item.Typ != token.BOOL && item.Typ != token.NULL {
+		p.unexpected(item, token.IDENT)
+	}
+	return &ast.Ident{NamePos: int64(item.Pos), Name: item.Val}
+}
+
+func (p *parser) parseExecutableDefs(defs *[]*ast.ExecutableDefinition) (docs []*ast.DocGroup_Doc) {
+	var cdocs []*ast.DocGroup_Doc
+	var last **ast.DocGroup // docs of the preceding definition
+	for {
+		item, trailing := p.nextDoc()
+		if item.Typ == token.EOF {
+			docs = append(docs, cdocs...)
+			return
+		}
+
+		ok := p.try(p.atExecutableDef, func() {
+			var def *ast.ExecutableDefinition
+			switch {
+			case item.Typ == token.ERR:
+				p.unexpected(item)
+			case item.Typ == token.COMMENT && trailing && last != nil:
+				trail(last, item)
+			case item.Typ == token.COMMENT:
+				last = nil
+				d := &ast.DocGroup_Doc{
+					Text:    item.Val,
+					Char:    int64(item.Pos),
+					Comment: true,
+				}
+
+				if len(cdocs) == 0 {
+					cdocs = append(cdocs, d)
+					break
+				}
+
+				prev := cdocs[len(cdocs)-1]
+				lprev := p.doc.Line(token.Pos(int(prev.Char) + len(prev.Text) - 1))
+				if p.doc.Line(token.Pos(d.Char))-lprev == 1 {
+					cdocs = append(cdocs, d)
+					break
+				}
+
+				docs = append(docs, cdocs...)
+				cdocs = cdocs[:0]
+				cdocs = append(cdocs, d)
+			case item.Typ == token.LBRACE:
+				op := &ast.OperationDefinition{
+					Op:           ast.OperationDefinition_QUERY,
+					SelectionSet: p.parseSelectionSet(item),
+				}
+				def = &ast.ExecutableDefinition{
+					Definition: &ast.ExecutableDefinition_Operation{Operation: op},
+				}
+			case item.Typ == token.IDENT:
+				var op ast.OperationDefinition_Operation
+				switch item.Val {
+				case "query":
+					op = ast.OperationDefinition_QUERY
+				case "mutation":
+					op = ast.OperationDefinition_MUTATION
+				case "subscription":
+					op = ast.OperationDefinition_SUBSCRIPTION
+				case "fragment":
+					def = &ast.ExecutableDefinition{
+						Definition: &ast.ExecutableDefinition_Fragment{Fragment: p.parseFragment(item)},
+					}
+				default:
+					p.unexpected(item)
+				}
+				if def != nil {
+					break
+				}
+
+				def = &ast.ExecutableDefinition{
+					Definition: &ast.ExecutableDefinition_Operation{Operation: p.parseOperation(item, op)},
+				}
+			default:
+				p.unexpected(item, token.IDENT, token.LBRACE)
+			}
+
+			if def == nil {
+				return
+			}
+			if dLen := len(cdocs); dLen > 0 {
+				def.Doc = &ast.DocGroup{List: make([]*ast.DocGroup_Doc, dLen)}
+				copy(def.Doc.List, cdocs)
+				cdocs = cdocs[:0]
+			}
+
+			*defs = append(*defs, def)
+			last = &def.Doc
+		})
+		if !ok {
+			cdocs, last = cdocs[:0], nil
+		}
+	}
+}
+
+func (p *parser) parseOperation(opItem lexer.Item, op ast.OperationDefinition_Operation) *ast.OperationDefinition {
+	def := &ast.OperationDefinition{
+		OpPos: int64(opItem.Pos),
+		Op:    op,
+	}
+
+	item := p.next()
+	if item.Typ == token.IDENT || item.Typ.IsKeyword() {
+		def.Name = &ast.Ident{NamePos: int64(item.Pos), Name: item.Val}
+		item = p.next()
+	}
+
+	if item.Typ == token.LPAREN {
+		def.Variables = p.parseVariableDefs(item)
+		item = p.next()
+	}
+
+	item = p.parseDirectiveList(item, &def.Directives, false)
+
+	if item.Typ != token.LBRACE {
+		p.unexpected(item, token.LBRACE)
+	}
+	def.SelectionSet = p.parseSelectionSet(item)
+	return def
+}
+
+func (p *parser) parseFragment(fragItem lexer.Item) *ast.FragmentDefinition {
+	item := p.next()
+	if item.Typ == token.ON {
+		p.errorf(item, ErrInvalidFragmentName, "fragment can not be named: on")
+	}
+
+	frag := &ast.FragmentDefinition{
+		Fragment: int64(fragItem.Pos),
+		Name:     p.expectNameFrom(item),
+	}
+
+	item = p.next()
+	if item.Typ != token.ON {
+		p.unexpected(item, token.ON)
+	}
+	frag.OnPos = int64(item.Pos)
+
+	frag.TypeCond = p.expectName()
+
+	item = p.parseDirectiveList(p.next(), &frag.Directives, false)
+
+	if item.Typ != token.LBRACE {
+		p.unexpected(item, token.LBRACE)
+	}
+	frag.SelectionSet = p.parseSelectionSet(item)
+	return frag
+}
+
+// parseDirectiveList parses any directives starting from item and returns the
+// first token which follows them.
+//
+func (p *parser) parseDirectiveList(item lexer.Item, directives *[]*ast.DirectiveLit, isConst bool) lexer.Item {
+	for item.Typ == token.AT {
+		p.pk = item
+		p.parseDirectives(directives, isConst)
+		item = p.next()
+	}
+	return item
+}
+
+func (p *parser) parseVariableDefs(lparen lexer.Item) *ast.VariableDefinitionList {
+	vars := &ast.VariableDefinitionList{
+		Opening: int64(lparen.Pos),
+	}
+
+	for {
+		item := p.next()
+		switch item.Typ {
+		case token.RPAREN:
+			vars.Closing = int64(item.Pos)
+			return vars
+		case token.VAR:
+		default:
+			p.unexpected(item, token.VAR, token.RPAREN)
+		}
+
+		v := &ast.VariableDefinition{
+			Variable: &ast.Variable{
+				Dollar: int64(item.Pos),
+				Name:   p.expectName(),
+			},
+		}
+		vars.List = append(vars.List, v)
+
+		p.expect(token.COLON)
+
+		typ := p.parseType()
+		switch t := typ.(type) {
+		case *ast.Ident:
+			v.Type = &ast.VariableDefinition_Ident{Ident: t}
+		case *ast.List:
+			v.Type = &ast.VariableDefinition_List{List: t}
+		case *ast.NonNull:
+			v.Type = &ast.VariableDefinition_NonNull{NonNull: t}
+		}
+
+		item = p.next()
+		if item.Typ == token.ASSIGN {
+			if item = p.peek(); item.Typ == token.VAR {
+				p.errorf(item, ErrNonConstantDefault, "variable default values must be constant: $%s", v.Variable.Name.Name)
+			}
+
+			switch dv := p.parseValue(true).(type) {
+			case *ast.BasicLit:
+				v.Default = &ast.VariableDefinition_BasicLit{BasicLit: dv}
+			case *ast.CompositeLit:
+				v.Default = &ast.VariableDefinition_CompositeLit{CompositeLit: dv}
+			}
+
+			item = p.next()
+		}
+
+		p.pk = p.parseDirectiveList(item, &v.Directives, true)
+	}
+}
+
+func (p *parser) parseSelectionSet(lbrace lexer.Item) *ast.SelectionSet {
+	set := &ast.SelectionSet{
+		Opening: int64(lbrace.Pos),
+	}
+
+	for {
+		item := p.next()
+		switch {
+		case item.Typ == token.RBRACE:
+			if len(set.List) == 0 {
+				p.errorf(item, ErrEmptySelectionSet, "selection set must contain at least one selection")
+			}
+			set.Closing = int64(item.Pos)
+			return set
+		case item.Typ == token.PERIOD:
+			set.List = append(set.List, p.parseFragmentSelection(item))
+		case item.Typ == token.IDENT || item.Typ.IsKeyword() || item.Typ == token.BOOL || item.Typ == token.NULL:
+			f := &ast.FieldSelection{
+				Name: &ast.Ident{NamePos: int64(item.Pos), Name: item.Val},
+			}
+			set.List = append(set.List, &ast.Selection{
+				Selection: &ast.Selection_Field{Field: f},
+			})
+
+			item = p.next()
+			if item.Typ == token.COLON {
+				f.Alias = f.Name
+				f.Name = p.expectName()
+				item = p.next()
+			}
+
+			if item.Typ == token.LPAREN {
+				f.Args = p.parseArgs(item, false)
+				item = p.next()
+			}
+
+			item = p.parseDirectiveList(item, &f.Directives, false)
+
+			if item.Typ == token.LBRACE {
+				f.SelectionSet = p.parseSelectionSet(item)
+				break
+			}
+			p.pk = item
+		default:
+			p.unexpected(item, token.IDENT, token.PERIOD, token.RBRACE)
+		}
+	}
+}
+
+// parseFragmentSelection parses either a fragment spread or an inline fragment.
+func (p *parser) parseFragmentSelection(spread lexer.Item) *ast.Selection {
+	item := p.next()
+	if item.Typ != token.ON && item.Typ != token.AT && item.Typ != token.LBRACE {
+		name := p.expectNameFrom(item)
+
+		fs := &ast.FragmentSpread{
+			Spread: int64(spread.Pos),
+			Name:   name,
+		}
+		p.pk = p.parseDirectiveList(p.next(), &fs.Directives, false)
+
+		return &ast.Selection{
+			Selection: &ast.Selection_FragmentSpread{FragmentSpread: fs},
+		}
+	}
+
+	inline := &ast.InlineFragment{
+		Spread: int64(spread.Pos),
+	}
+	if item.Typ == token.ON {
+		inline.OnPos = int64(item.Pos)
+		inline.TypeCond = p.expectName()
+		item = p.next()
+	}
+
+	item = p.parseDirectiveList(item, &inline.Directives, false)
+
+	if item.Typ != token.LBRACE {
+		p.unexpected(item, token.LBRACE)
+	}
+	inline.SelectionSet = p.parseSelectionSet(item)
+
+	return &ast.Selection{
+		Selection: &ast.Selection_InlineFragment{InlineFragment: inline},
+	}
+}
@@ -2,10 +2,12 @@ package parser
 
 import (
 	"bytes"
+	"errors"
 	"flag"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -394,7 +396,7 @@ func TestDirectives(t *testing.T) {
 			p.l = lexer.Lex(dset.AddDoc(testCase.Name, dset.Base(), len(testCase.Src)), testCase.Src)
 
 			var directives []*ast.DirectiveLit
-			p.parseDirectives(&directives)
+			p.parseDirectives(&directives, true)
 
 			if len(directives) != len(testCase.Ex) {
 				subT.Fail()
@@ -757,6 +759,43 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "InterfaceWithInterfaces",
+			Src: `interface Test implements A & B {
+	a: A
+}`,
+			Ex: &ast.Document{
+				Types: []*ast.TypeDecl{
+					{
+						TokPos: 1,
+						Tok:    token.INTERFACE,
+						Spec: &ast.TypeDecl_TypeSpec{TypeSpec: &ast.TypeSpec{
+							Name: &ast.Ident{NamePos: 11, Name: "Test"},
+							Type: &ast.TypeSpec_Interface{Interface: &ast.InterfaceType{
+								Interface: 1,
+								ImplPos:   16,
+								Interfaces: []*ast.Ident{
+									{NamePos: 27, Name: "A"},
+									{NamePos: 31, Name: "B"},
+								},
+								Fields: &ast.FieldList{
+									Opening: 33,
+									List: []*ast.Field{
+										{
+											Name: &ast.Ident{NamePos: 36, Name: "a"},
+											Type: &ast.Field_Ident{
+												Ident: &ast.Ident{NamePos: 39, Name: "A"},
+											},
+										},
+									},
+									Closing: 41,
+								},
+							}},
+						}},
+					},
+				},
+			},
+		},
 		{
 			Name: "Union",
 			Src:  `union Test @a = A | B | C`,
@@ -866,6 +905,60 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "RepeatableDirective",
+			Src:  `directive @test repeatable on OBJECT`,
+			Ex: &ast.Document{
+				Types: []*ast.TypeDecl{
+					{
+						TokPos: 1,
+						Tok:    token.DIRECTIVE,
+						Spec: &ast.TypeDecl_TypeSpec{TypeSpec: &ast.TypeSpec{
+							Name: &ast.Ident{NamePos: 12, Name: "test"},
+							Type: &ast.TypeSpec_Directive{Directive: &ast.DirectiveType{
+								Directive:     1,
+								Repeatable:    true,
+								RepeatablePos: 17,
+								OnPos:         28,
+								Locs: []*ast.DirectiveLocation{
+									{Start: 31, Loc: ast.DirectiveLocation_OBJECT},
+								},
+							}},
+						}},
+					},
+				},
+			},
+		},
+		{
+			Name: "RepeatableAsName",
+			Src:  `type repeatable { repeatable: repeatable }`,
+			Ex: &ast.Document{
+				Types: []*ast.TypeDecl{
+					{
+						TokPos: 1,
+						Tok:    token.TYPE,
+						Spec: &ast.TypeDecl_TypeSpec{TypeSpec: &ast.TypeSpec{
+							Name: &ast.Ident{NamePos: 6, Name: "repeatable"},
+							Type: &ast.TypeSpec_Object{Object: &ast.ObjectType{
+								Object: 1,
+								Fields: &ast.FieldList{
+									Opening: 17,
+									List: []*ast.Field{
+										{
+											Name: &ast.Ident{NamePos: 19, Name: "repeatable"},
+											Type: &ast.Field_Ident{
+												Ident: &ast.Ident{NamePos: 31, Name: "repeatable"},
+											},
+										},
+									},
+									Closing: 42,
+								},
+							}},
+						}},
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -898,6 +991,804 @@ func TestParser(t *testing.T) {
 	}
 }
 
+func TestParseQuery(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Src  string
+		Ex   *ast.ExecutableDocument
+	}{
+		{
+			Name: "Shorthand",
+			Src:  `{ a }`,
+			Ex: &ast.ExecutableDocument{
+				Definitions: []*ast.ExecutableDefinition{
+					{
+						Definition: &ast.ExecutableDefinition_Operation{Operation: &ast.OperationDefinition{
+							Op: ast.OperationDefinition_QUERY,
+							SelectionSet: &ast.SelectionSet{
+								Opening: 1,
+								List: []*ast.Selection{
+									{Selection: &ast.Selection_Field{Field: &ast.FieldSelection{
+										Name: &ast.Ident{NamePos: 3, Name: "a"},
+									}}},
+								},
+								Closing: 5,
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			Name: "Operation",
+			Src: `mutation Test($a: Int! = 1) @a {
+	b: c(d: $a) { e }
+}`,
+			Ex: &ast.ExecutableDocument{
+				Definitions: []*ast.ExecutableDefinition{
+					{
+						Definition: &ast.ExecutableDefinition_Operation{Operation: &ast.OperationDefinition{
+							OpPos: 1,
+							Op:    ast.OperationDefinition_MUTATION,
+							Name:  &ast.Ident{NamePos: 10, Name: "Test"},
+							Variables: &ast.VariableDefinitionList{
+								Opening: 14,
+								List: []*ast.VariableDefinition{
+									{
+										Variable: &ast.Variable{Dollar: 15, Name: &ast.Ident{NamePos: 16, Name: "a"}},
+										Type: &ast.VariableDefinition_NonNull{NonNull: &ast.NonNull{
+											Type: &ast.NonNull_Ident{Ident: &ast.Ident{NamePos: 19, Name: "Int"}},
+										}},
+										Default: &ast.VariableDefinition_BasicLit{
+											BasicLit: &ast.BasicLit{Kind: token.INT, ValuePos: 26, Value: "1"},
+										},
+									},
+								},
+								Closing: 27,
+							},
+							Directives: []*ast.DirectiveLit{
+								{AtPos: 29, Name: "a"},
+							},
+							SelectionSet: &ast.SelectionSet{
+								Opening: 32,
+								List: []*ast.Selection{
+									{Selection: &ast.Selection_Field{Field: &ast.FieldSelection{
+										Alias: &ast.Ident{NamePos: 35, Name: "b"},
+										Name:  &ast.Ident{NamePos: 38, Name: "c"},
+										Args: &ast.CallExpr{
+											Lparen: 39,
+											Args: []*ast.Arg{
+												{
+													Name: &ast.Ident{NamePos: 40, Name: "d"},
+													Value: &ast.Arg_Variable{Variable: &ast.Variable{
+														Dollar: 43,
+														Name:   &ast.Ident{NamePos: 44, Name: "a"},
+													}},
+												},
+											},
+											Rparen: 45,
+										},
+										SelectionSet: &ast.SelectionSet{
+											Opening: 47,
+											List: []*ast.Selection{
+												{Selection: &ast.Selection_Field{Field: &ast.FieldSelection{
+													Name: &ast.Ident{NamePos: 49, Name: "e"},
+												}}},
+											},
+											Closing: 51,
+										},
+									}}},
+								},
+								Closing: 53,
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			Name: "Fragments",
+			Src:  `fragment A on B { ...C ... on D { e } ... @f { g } }`,
+			Ex: &ast.ExecutableDocument{
+				Definitions: []*ast.ExecutableDefinition{
+					{
+						Definition: &ast.ExecutableDefinition_Fragment{Fragment: &ast.FragmentDefinition{
+							Fragment: 1,
+							Name:     &ast.Ident{NamePos: 10, Name: "A"},
+							OnPos:    12,
+							TypeCond: &ast.Ident{NamePos: 15, Name: "B"},
+							SelectionSet: &ast.SelectionSet{
+								Opening: 17,
+								List: []*ast.Selection{
+									{Selection: &ast.Selection_FragmentSpread{FragmentSpread: &ast.FragmentSpread{
+										Spread: 19,
+										Name:   &ast.Ident{NamePos: 22, Name: "C"},
+									}}},
+									{Selection: &ast.Selection_InlineFragment{InlineFragment: &ast.InlineFragment{
+										Spread:   24,
+										OnPos:    28,
+										TypeCond: &ast.Ident{NamePos: 31, Name: "D"},
+										SelectionSet: &ast.SelectionSet{
+											Opening: 33,
+											List: []*ast.Selection{
+												{Selection: &ast.Selection_Field{Field: &ast.FieldSelection{
+													Name: &ast.Ident{NamePos: 35, Name: "e"},
+												}}},
+											},
+											Closing: 37,
+										},
+									}}},
+									{Selection: &ast.Selection_InlineFragment{InlineFragment: &ast.InlineFragment{
+										Spread: 39,
+										Directives: []*ast.DirectiveLit{
+											{AtPos: 43, Name: "f"},
+										},
+										SelectionSet: &ast.SelectionSet{
+											Opening: 46,
+											List: []*ast.Selection{
+												{Selection: &ast.Selection_Field{Field: &ast.FieldSelection{
+													Name: &ast.Ident{NamePos: 48, Name: "g"},
+												}}},
+											},
+											Closing: 50,
+										},
+									}}},
+								},
+								Closing: 52,
+							},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			doc, err := ParseQuery(token.NewDocSet(), "", strings.NewReader(testCase.Src), 0)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+
+			if !proto.Equal(doc, testCase.Ex) {
+				subT.Logf("Found document inequality:\nOut: %s\nExp: %s\n", doc, testCase.Ex)
+				subT.Fail()
+			}
+		})
+	}
+}
+
+func TestParseQueryMultiLine(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Src   string
+		Multi string
+	}{
+		{
+			Name: "ObjectKeys",
+			Src:  `{ a(filter: {type: FOO, input: 1, on: true, query: null}) }`,
+			Multi: `{
+	a(filter: {
+		type: FOO
+		input: 1
+		on: true
+		query: null
+	})
+}`,
+		},
+		{
+			Name: "ValueComments",
+			Src:  `{ a(x: [1 2], y: {z: 3}) }`,
+			Multi: `{
+	a(x: [1 # one
+		2], y: { # y
+		z: # z
+			3
+	})
+}`,
+		},
+		{
+			Name: "VariableComments",
+			Src:  `query Q($a: [Int!] = [1] @d) { a }`,
+			Multi: `query Q( # vars
+	$a: # a
+		[ # list
+			Int # int
+			! # non-null
+		] = # default
+		[1] # value
+		@d # directive
+) { a }`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			src, err := ParseQuery(token.NewDocSet(), testCase.Name, strings.NewReader(testCase.Src), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			multi, err := ParseQuery(token.NewDocSet(), testCase.Name, strings.NewReader(testCase.Multi), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			clearPos(reflect.ValueOf(src))
+			clearPos(reflect.ValueOf(multi))
+			if !proto.Equal(multi, src) {
+				subT.Errorf("Found document inequality:\nOut: %s\nExp: %s\n", multi, src)
+			}
+		})
+	}
+}
+
+func TestParseQueryErrs(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Src  string
+	}{
+		{Name: "UnknownOperation", Src: `quary { a }`},
+		{Name: "EmptySelectionSet", Src: `{}`},
+		{Name: "MissingTypeCondition", Src: `fragment A { a }`},
+		{Name: "FragmentNamedOn", Src: `fragment on on A { a }`},
+		{Name: "VariableDefault", Src: `query ($a: Int = $b) { a }`},
+		{Name: "UnclosedSelectionSet", Src: `{ a { b }`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			_, err := ParseQuery(token.NewDocSet(), "", strings.NewReader(testCase.Src), 0)
+			if err == nil {
+				subT.Fail()
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Src      string
+		Query    bool
+		Code     ErrorCode
+		Line     int
+		Column   int
+		Found    token.Token
+		Expected []token.Token
+		Msg      string
+	}{
+		{
+			Name:     "MissingColon",
+			Src:      "type A {\n  a Int\n}",
+			Code:     ErrUnexpectedToken,
+			Line:     2,
+			Column:   5,
+			Found:    token.IDENT,
+			Expected: []token.Token{token.COLON},
+			Msg:      `expected ':', found "Int"`,
+		},
+		{
+			Name:     "MissingOn",
+			Src:      "fragment A B { a }",
+			Query:    true,
+			Code:     ErrUnexpectedToken,
+			Line:     1,
+			Column:   12,
+			Found:    token.IDENT,
+			Expected: []token.Token{token.ON},
+			Msg:      `expected "on", found "B"`,
+		},
+		{
+			Name:   "UnknownTypeDecl",
+			Src:    "unknownType Test",
+			Code:   ErrUnexpectedToken,
+			Line:   1,
+			Column: 1,
+			Found:  token.IDENT,
+			Msg:    `unexpected "unknownType"`,
+		},
+		{
+			Name:     "InvalidTypeExtension",
+			Src:      "extend unknownType Test",
+			Code:     ErrUnexpectedToken,
+			Line:     1,
+			Column:   8,
+			Found:    token.IDENT,
+			Expected: []token.Token{token.SCHEMA, token.SCALAR, token.TYPE, token.INTERFACE, token.UNION, token.ENUM, token.INPUT},
+			Msg:      `expected "schema" or "scalar" or "type" or "interface" or "union" or "enum" or "input", found "unknownType"`,
+		},
+		{
+			Name:   "InvalidDirectiveLocation",
+			Src:    "directive @a on FOO",
+			Code:   ErrInvalidDirectiveLocation,
+			Line:   1,
+			Column: 17,
+			Found:  token.IDENT,
+			Msg:    "invalid directive location: FOO",
+		},
+		{
+			Name:   "Lexical",
+			Src:    "type A {\n  a: Int\n}\n\"abc",
+			Code:   ErrLexical,
+			Line:   4,
+			Column: 1,
+			Found:  token.ERR,
+			Msg:    `bad string syntax: "abc`,
+		},
+		{
+			Name:     "UnexpectedEOF",
+			Src:      "{ a ",
+			Query:    true,
+			Code:     ErrUnexpectedEOF,
+			Line:     1,
+			Column:   5,
+			Found:    token.EOF,
+			Expected: []token.Token{token.IDENT, token.PERIOD, token.RBRACE},
+			Msg:      "expected name or '...' or '}', found EOF",
+		},
+		{
+			Name:   "EmptySelectionSet",
+			Src:    "query {\n}",
+			Query:  true,
+			Code:   ErrEmptySelectionSet,
+			Line:   2,
+			Column: 1,
+			Found:  token.RBRACE,
+			Msg:    "selection set must contain at least one selection",
+		},
+		{
+			Name:   "NonConstantDefault",
+			Src:    "query ($a: Int = $b) { a }",
+			Query:  true,
+			Code:   ErrNonConstantDefault,
+			Line:   1,
+			Column: 18,
+			Found:  token.VAR,
+			Msg:    "variable default values must be constant: $a",
+		},
+		{
+			Name:   "NestedNonConstantDefault",
+			Src:    "query Q($a: [Int] = [$b]) { a }",
+			Query:  true,
+			Code:   ErrNonConstantValue,
+			Line:   1,
+			Column: 22,
+			Found:  token.VAR,
+			Msg:    "variables are not allowed in constant values",
+		},
+		{
+			Name:   "VariableDirectiveVariable",
+			Src:    "query Q($a: Int @d(x: {y: $b})) { a }",
+			Query:  true,
+			Code:   ErrNonConstantValue,
+			Line:   1,
+			Column: 27,
+			Found:  token.VAR,
+			Msg:    "variables are not allowed in constant values",
+		},
+		{
+			Name:   "VariableArgDefault",
+			Src:    "type T { f(a: Int = $x): Int }",
+			Code:   ErrNonConstantValue,
+			Line:   1,
+			Column: 21,
+			Found:  token.VAR,
+			Msg:    "variables are not allowed in constant values",
+		},
+		{
+			Name:   "VariableDirectiveArg",
+			Src:    "scalar S @d(a: [$x])",
+			Code:   ErrNonConstantValue,
+			Line:   1,
+			Column: 17,
+			Found:  token.VAR,
+			Msg:    "variables are not allowed in constant values",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			var err error
+			if testCase.Query {
+				_, err = ParseQuery(token.NewDocSet(), testCase.Name, strings.NewReader(testCase.Src), 0)
+			} else {
+				_, err = ParseDoc(token.NewDocSet(), testCase.Name, strings.NewReader(testCase.Src), 0)
+			}
+
+			errs, ok := err.(ErrorList)
+			if !ok || len(errs) != 1 {
+				subT.Fatalf("expected a single error but instead received: %#v", err)
+			}
+
+			e := errs[0]
+			if e.Code != testCase.Code {
+				subT.Errorf("expected code: %s but instead received: %s", testCase.Code, e.Code)
+			}
+			if e.Position.Filename != testCase.Name || e.Position.Line != testCase.Line || e.Position.Column != testCase.Column {
+				subT.Errorf("expected position: %s:%d:%d but instead received: %s", testCase.Name, testCase.Line, testCase.Column, e.Position)
+			}
+			if e.Found.Typ != testCase.Found {
+				subT.Errorf("expected found token: %s but instead received: %s", testCase.Found, e.Found.Typ)
+			}
+			if !reflect.DeepEqual(e.Expected, testCase.Expected) {
+				subT.Errorf("expected tokens: %v but instead received: %v", testCase.Expected, e.Expected)
+			}
+			if e.Msg != testCase.Msg {
+				subT.Errorf("expected message: %q but instead received: %q", testCase.Msg, e.Msg)
+			}
+
+			var lexErr *lexer.Error
+			if errors.As(e, &lexErr) != (testCase.Code == ErrLexical) {
+				subT.Errorf("expected lexer error to be unwrapped iff code is: %s", ErrLexical)
+			}
+		})
+	}
+}
+
+func TestAllErrors(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Src   string
+		Parse func(dset *token.DocSet, src string) (names []string, err error)
+		Names []string
+		Lines []int
+	}{
+		{
+			Name: "Doc",
+			Src: `scalar Time
+
+type A {
+  a Int
+}
+
+type B {
+  b: Int
+}
+
+extend unknown C
+
+directive @d on FOO
+
+scalar Last
+`,
+			Parse: func(dset *token.DocSet, src string) (names []string, err error) {
+				doc, err := ParseDoc(dset, "Doc", strings.NewReader(src), AllErrors)
+				for _, td := range doc.Types {
+					names = append(names, td.Spec.(*ast.TypeDecl_TypeSpec).TypeSpec.Name.Name)
+				}
+				return
+			},
+			Names: []string{"Time", "B", "Last"},
+			Lines: []int{4, 11, 13},
+		},
+		{
+			Name: "UnclosedBrace",
+			Src: `type A {
+  a: Int
+
+type B { b: Int }
+type C { c: }
+type D { d: Int! ! }
+scalar Last
+`,
+			Parse: func(dset *token.DocSet, src string) (names []string, err error) {
+				doc, err := ParseDoc(dset, "UnclosedBrace", strings.NewReader(src), AllErrors)
+				for _, td := range doc.Types {
+					names = append(names, td.Spec.(*ast.TypeDecl_TypeSpec).TypeSpec.Name.Name)
+				}
+				return
+			},
+			Names: []string{"Last"},
+			Lines: []int{4, 5, 6},
+		},
+		{
+			Name: "UnclosedParen",
+			Src: `type A {
+  a(b: Int: String
+}
+type B { b: }
+scalar Last
+`,
+			Parse: func(dset *token.DocSet, src string) (names []string, err error) {
+				doc, err := ParseDoc(dset, "UnclosedParen", strings.NewReader(src), AllErrors)
+				for _, td := range doc.Types {
+					names = append(names, td.Spec.(*ast.TypeDecl_TypeSpec).TypeSpec.Name.Name)
+				}
+				return
+			},
+			Names: []string{"Last"},
+			Lines: []int{2, 4},
+		},
+		{
+			Name: "Query",
+			Src: `{ a }
+query Q { b(x: ) }
+query R { c }
+fragment F on T { ..d }
+{ e }
+`,
+			Parse: func(dset *token.DocSet, src string) (names []string, err error) {
+				doc, err := ParseQuery(dset, "Query", strings.NewReader(src), AllErrors)
+				for _, def := range doc.Definitions {
+					op := def.Definition.(*ast.ExecutableDefinition_Operation).Operation
+					names = append(names, op.SelectionSet.List[0].Selection.(*ast.Selection_Field).Field.Name.Name)
+				}
+				return
+			},
+			Names: []string{"a", "c", "e"},
+			Lines: []int{2, 4},
+		},
+		{
+			Name: "UnclosedQuery",
+			Src: `query Q { a { b }
+query R { c(x: ) }
+query S { e(x: ) }
+{ d }
+`,
+			Parse: func(dset *token.DocSet, src string) (names []string, err error) {
+				doc, err := ParseQuery(dset, "UnclosedQuery", strings.NewReader(src), AllErrors)
+				for _, def := range doc.Definitions {
+					op := def.Definition.(*ast.ExecutableDefinition_Operation).Operation
+					names = append(names, op.SelectionSet.List[0].Selection.(*ast.Selection_Field).Field.Name.Name)
+				}
+				return
+			},
+			Names: []string{"d"},
+			Lines: []int{2, 3},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			names, err := testCase.Parse(token.NewDocSet(), testCase.Src)
+
+			errs, ok := err.(ErrorList)
+			if !ok {
+				subT.Fatalf("expected an ErrorList but instead received: %#v", err)
+			}
+			if len(errs) != len(testCase.Lines) {
+				subT.Fatalf("expected %d errors but instead received: %s", len(testCase.Lines), errs)
+			}
+			for i, e := range errs {
+				if e.Position.Filename != testCase.Name || e.Position.Line != testCase.Lines[i] {
+					subT.Errorf("expected error on line %d but instead received: %s", testCase.Lines[i], e)
+				}
+			}
+
+			if strings.Join(names, ",") != strings.Join(testCase.Names, ",") {
+				subT.Errorf("expected partial document with: %v but instead received: %v", testCase.Names, names)
+			}
+		})
+	}
+}
+
+func TestMultiLine(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Src   string
+		Multi string
+	}{
+		{
+			Name: "ScalarDirectives",
+			Src:  `scalar Time @a @b(c: 1)`,
+			Multi: `scalar Time
+	@a
+	@b(
+		c: 1
+	)`,
+		},
+		{
+			Name: "ObjectHeader",
+			Src:  `type Test implements A & B @a { a: A }`,
+			Multi: `type Test
+	implements
+		& A
+		& B
+	@a
+{
+	a: A
+}`,
+		},
+		{
+			Name: "InterfaceHeader",
+			Src:  `interface Test implements A @a { a: A }`,
+			Multi: `interface Test
+	implements A
+	@a {
+	a: A
+}`,
+		},
+		{
+			Name: "UnionMembers",
+			Src:  `union Test @a = A | B | C`,
+			Multi: `union Test
+	@a
+	=
+	| A
+	| B
+	| C`,
+		},
+		{
+			Name: "Fields",
+			Src:  `type Test { a(b: B = 1 @c, d: [D!] = ["d"]): A! @e @f(g: {h: "h"}) i: I }`,
+			Multi: `type Test {
+	a(
+		b: B = 1
+			@c
+		d: [D!] = [
+			"d"
+		]
+	): A!
+		@e
+		@f(g: {
+			h: "h"
+		})
+	i:
+		I
+}`,
+		},
+		{
+			Name: "Descriptions",
+			Src:  `"Test" type Test { "a" a("b" b: B): A }`,
+			Multi: `"Test"
+type Test {
+	"a"
+	a(
+		"b"
+		b: B
+	): A
+}`,
+		},
+		{
+			Name: "Directive",
+			Src:  `directive @test(a: A) repeatable on OBJECT | FIELD_DEFINITION`,
+			Multi: `directive @test(
+	a: A
+)
+	repeatable
+	on
+		| OBJECT
+		| FIELD_DEFINITION`,
+		},
+		{
+			Name: "Extension",
+			Src:  `extend type Test implements A @a { b: B }`,
+			Multi: `extend
+type Test
+	implements A
+	@a
+{
+	b: B
+}`,
+		},
+		{
+			Name: "Schema",
+			Src:  `schema @a { query: Query mutation: Mutation }`,
+			Multi: `schema
+	@a
+{
+	query: Query,
+	mutation: Mutation
+}`,
+		},
+		{
+			Name: "Consecutive",
+			Src:  `scalar A type B union C = A | B enum D { E F } input G { h: H = 1 }`,
+			Multi: `scalar A
+type B
+union C =
+	A | B
+enum D { E
+	F }
+input G {
+	h: H
+		= 1
+}`,
+		},
+		{
+			Name: "FieldComments",
+			Src:  `type Test { a: Int b(c: Int): Int }`,
+			Multi: `type Test { # fields
+	a: Int # a
+	b( # args
+		c: Int # c
+	): Int # b
+}`,
+		},
+		{
+			Name: "EnumComments",
+			Src:  `enum E { A B }`,
+			Multi: `enum E {
+	A # a
+	B
+}`,
+		},
+		{
+			Name: "HeaderComments",
+			Src:  `type A implements B @c { d: Int } type E @f(x: 1) { g: Int } input H @i { j: Int = [1] }`,
+			Multi: `type A # a
+	implements B # b
+	@c # c
+{ d: Int }
+type E @f( # f
+	x: 1 # x
+) # e
+{ g: Int }
+input H # h
+	@i # i
+{ j: Int = [ # j
+	1 # 1
+] }`,
+		},
+		{
+			Name: "DirectiveComments",
+			Src:  `directive @d(a: Int) repeatable on FIELD | OBJECT`,
+			Multi: `directive @d(a: Int) # d
+	repeatable # repeatable
+	on # on
+	| FIELD # field
+	| OBJECT`,
+		},
+		{
+			Name: "UnionComments",
+			Src:  `union U = A | B`,
+			Multi: `union U # u
+	= A # a
+	| B`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			src, err := ParseDoc(token.NewDocSet(), testCase.Name, strings.NewReader(testCase.Src), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			multi, err := ParseDoc(token.NewDocSet(), testCase.Name, strings.NewReader(testCase.Multi), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			// comments never change whether a document parses
+			_, err = ParseDoc(token.NewDocSet(), testCase.Name, strings.NewReader(testCase.Multi), ParseComments)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			clearPos(reflect.ValueOf(src))
+			clearPos(reflect.ValueOf(multi))
+			compare(subT, multi, src)
+		})
+	}
+}
+
+// clearPos zeroes every position in a node, so that nodes
+// can be compared no matter how their source was formatted.
+//
+func clearPos(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			clearPos(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			clearPos(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			clearPos(v.Field(i))
+		}
+	case reflect.Int64:
+		if v.CanSet() {
+			v.SetInt(0)
+		}
+	}
+}
+
 func TestParseDoc(t *testing.T) {
 	doc, err := ParseDoc(token.NewDocSet(), "test", bytes.NewReader(gqlSrc), ParseComments)
 	if err != nil {
@@ -921,6 +1812,92 @@ func BenchmarkParseDoc(b *testing.B) {
 	}
 }
 
+func TestCommentGroups(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Src   string
+		Doc   []string // docs of the document
+		Type  []string // docs of the first type
+		Field []string // docs of its first field
+	}{
+		{
+			Name: "CommentBeforeDescription",
+			Src: `# comment
+"description"
+type T`,
+			Type: []string{"# comment\n", `"description"`},
+		},
+		{
+			Name: "DetachedComment",
+			Src: `# detached
+
+"description"
+type T`,
+			Doc:  []string{"# detached\n"},
+			Type: []string{`"description"`},
+		},
+		{
+			Name: "FieldCommentBeforeDescription",
+			Src: `type T {
+  # comment
+  "description"
+  f: Int
+}`,
+			Field: []string{"# comment\n", `"description"`},
+		},
+		{
+			Name: "TrailingComments",
+			Src: `type T { # fields
+  f: Int # f
+  # g
+  g: Int
+} # T
+# doc`,
+			Doc:   []string{"# doc"},
+			Type:  []string{"# T\n"},
+			Field: []string{"# fields\n", "# f\n"},
+		},
+		{
+			Name: "CommentAtEOF",
+			Src: `scalar S
+# eof`,
+			Doc: []string{"# eof"},
+		},
+	}
+
+	texts := func(dg *ast.DocGroup) (s []string) {
+		for _, d := range dg.GetList() {
+			s = append(s, d.Text)
+		}
+		return
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			doc, err := ParseDoc(token.NewDocSet(), testCase.Name, strings.NewReader(testCase.Src), ParseComments)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			ts := doc.Types[0]
+			var field *ast.Field
+			if fields := ts.GetTypeSpec().GetObject().GetFields().GetList(); len(fields) > 0 {
+				field = fields[0]
+			}
+
+			if s := texts(doc.Doc); !reflect.DeepEqual(s, testCase.Doc) {
+				subT.Errorf("mismatched document docs: %q, expected: %q", s, testCase.Doc)
+			}
+			if s := texts(ts.Doc); !reflect.DeepEqual(s, testCase.Type) {
+				subT.Errorf("mismatched type docs: %q, expected: %q", s, testCase.Type)
+			}
+			if s := texts(field.GetDoc()); !reflect.DeepEqual(s, testCase.Field) {
+				subT.Errorf("mismatched field docs: %q, expected: %q", s, testCase.Field)
+			}
+		})
+	}
+}
+
 func TestParseDir(t *testing.T) {
 	docs, err := ParseDir(token.NewDocSet(), "./testdir", nil, ParseComments)
 	if err != nil {
@@ -933,6 +1910,50 @@ func TestParseDir(t *testing.T) {
 		t.Fail()
 		return
 	}
+
+	name := filepath.Join("testdir", "test.gql")
+	if doc, ok := docs[name]; !ok || doc.Name != name {
+		t.Errorf("expected doc to be keyed and named by its path: %s", name)
+	}
+
+	t.Run("Errors", func(subT *testing.T) {
+		dir, err := ioutil.TempDir("", "parsedir")
+		if err != nil {
+			subT.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		files := map[string]string{
+			"a.gql":          "type T { a: A }",
+			"sub/a.gql":      "type U { a: A }",
+			"sub/bad.gql":    "type T {",
+			"sub/ignore.txt": "type T {",
+		}
+		for name, src := range files {
+			p := filepath.Join(dir, name)
+			if err = os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+				subT.Fatal(err)
+			}
+			if err = ioutil.WriteFile(p, []byte(src), 0644); err != nil {
+				subT.Fatal(err)
+			}
+		}
+
+		docs, err := ParseDir(token.NewDocSet(), dir, nil, 0)
+		list, ok := err.(ErrorList)
+		if !ok || len(list) != 1 || list[0].Position.Filename != filepath.Join(dir, "sub/bad.gql") {
+			subT.Errorf("expected a single error for sub/bad.gql, got: %v", err)
+		}
+
+		for _, name := range []string{"a.gql", "sub/a.gql"} {
+			if _, ok := docs[filepath.Join(dir, name)]; !ok {
+				subT.Errorf("missing doc: %s", name)
+			}
+		}
+		if len(docs) != 2 {
+			subT.Errorf("expected 2 docs, got: %d", len(docs))
+		}
+	})
 }
 
 func parse(name, src string) (*ast.Document, error) {
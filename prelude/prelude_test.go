@@ -0,0 +1,80 @@
+package prelude
+
+import "testing"
+
+func TestDocument(t *testing.T) {
+	doc := Document()
+	if doc != Document() {
+		t.Fatal("expected the prelude to be parsed once")
+	}
+	if doc.Name != Name {
+		t.Errorf("unexpected document name: %s", doc.Name)
+	}
+
+	var types, directives int
+	for _, td := range doc.Types {
+		ts := td.GetTypeSpec()
+		if ts == nil {
+			t.Fatalf("unexpected declaration: %v", td)
+		}
+
+		name := ts.Name.Name
+		if ts.GetDirective() != nil {
+			directives++
+			if !IsBuiltinDirective(name) {
+				t.Errorf("expected @%s to be a built-in directive", name)
+			}
+			continue
+		}
+
+		types++
+		if !IsBuiltin(name) {
+			t.Errorf("expected %s to be a built-in type", name)
+		}
+		if ts.GetScalar() != nil != IsBuiltinScalar(name) {
+			t.Errorf("expected %s to be a built-in scalar", name)
+		}
+		if pos := DocSet().Position(ts.Name.Pos()); pos.Filename != Name || pos.Line == 0 {
+			t.Errorf("unexpected position of %s: %s", name, pos)
+		}
+	}
+	if types != 13 || directives != 4 {
+		t.Errorf("expected 13 types and 4 directives, got: %d and %d", types, directives)
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Scalar    bool
+		Directive bool
+		Intro     bool
+	}{
+		{Name: "Int", Scalar: true},
+		{Name: "ID", Scalar: true},
+		{Name: "Time"},
+		{Name: "deprecated", Directive: true},
+		{Name: "specifiedBy", Directive: true},
+		{Name: "tag"},
+		{Name: "__Type", Intro: true},
+		{Name: "__DirectiveLocation", Intro: true},
+		{Name: "__Query"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			if IsBuiltinScalar(testCase.Name) != testCase.Scalar {
+				subT.Errorf("IsBuiltinScalar: expected %v", testCase.Scalar)
+			}
+			if IsBuiltinDirective(testCase.Name) != testCase.Directive {
+				subT.Errorf("IsBuiltinDirective: expected %v", testCase.Directive)
+			}
+			if IsIntrospectionType(testCase.Name) != testCase.Intro {
+				subT.Errorf("IsIntrospectionType: expected %v", testCase.Intro)
+			}
+			if IsBuiltin(testCase.Name) != (testCase.Scalar || testCase.Intro) {
+				subT.Errorf("IsBuiltin: expected %v", testCase.Scalar || testCase.Intro)
+			}
+		})
+	}
+}
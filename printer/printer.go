@@ -0,0 +1,741 @@
+// Package printer implements printing of GraphQL AST nodes as SDL.
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/prelude"
+	"github.com/gqlc/graphql/token"
+)
+
+// Mode represents a printing mode.
+type Mode uint
+
+// Mode Options
+const (
+	UseSpaces  Mode = 1 << iota // indent with spaces instead of tabs
+	OmitCommas                  // separate inline arguments and values by spaces only
+	Prelude                     // print the prelude before an *ast.Document
+)
+
+// A Config node controls the output of Fprint.
+type Config struct {
+	Mode     Mode // default: 0
+	Tabwidth int  // number of spaces per indentation level, if UseSpaces is set
+	Indent   int  // additional indentation levels for all lines; default: 0
+}
+
+// Fprint "pretty-prints" a GraphQL AST node to w. The node must be
+// an *ast.Document or an *ast.TypeDecl.
+//
+// Position information in dset is used to preserve blank lines and the
+// placement of comments; dset may be nil if the node was not parsed.
+//
+func (cfg *Config) Fprint(w io.Writer, dset *token.DocSet, node interface{}) error {
+	p := &printer{
+		Config: *cfg,
+		dset:   dset,
+		indent: cfg.Indent,
+	}
+
+	switch n := node.(type) {
+	case *ast.Document:
+		if cfg.Mode&Prelude != 0 {
+			p.prelude()
+		}
+		p.document(n)
+	case *ast.TypeDecl:
+		p.decl(n)
+	default:
+		return fmt.Errorf("printer: unsupported node type %T", node)
+	}
+	if p.out.Len() > 0 {
+		p.out.WriteByte('\n')
+	}
+
+	_, err := w.Write(p.out.Bytes())
+	return err
+}
+
+// Fprint "pretty-prints" a GraphQL AST node to w, indenting
+// with two spaces and separating inline values by commas.
+//
+func Fprint(w io.Writer, dset *token.DocSet, node interface{}) error {
+	cfg := &Config{Mode: UseSpaces, Tabwidth: 2}
+	return cfg.Fprint(w, dset, node)
+}
+
+type printer struct {
+	Config
+	dset *token.DocSet
+	out  bytes.Buffer
+
+	indent   int
+	lastLine int  // source line of the last printed token; or 0, if unknown
+	open     bool // the next line must not be preceded by a blank line
+	sep      bool // the next line must be preceded by a blank line
+
+	// docs are the pending docs of the innermost node being printed.
+	// They are sorted by position.
+	docs []*ast.DocGroup_Doc
+}
+
+// line returns the source line of pos; or 0, if unknown.
+func (p *printer) line(pos int64) int {
+	if p.dset == nil || pos <= 0 {
+		return 0
+	}
+	return p.dset.Position(token.Pos(pos)).Line
+}
+
+// mark records that the src at pos has been printed.
+func (p *printer) mark(pos int64) {
+	if l := p.line(pos); l > p.lastLine {
+		p.lastLine = l
+	}
+}
+
+// newline starts a new line at the current indentation for the src at pos.
+// At most one blank line preceding pos in the source is preserved.
+//
+func (p *printer) newline(pos int64) {
+	if p.out.Len() == 0 {
+		p.sep, p.open = false, false
+		p.writeIndent()
+		return
+	}
+
+	p.out.WriteByte('\n')
+	l := p.line(pos)
+	if p.sep || !p.open && p.lastLine > 0 && l-p.lastLine > 1 {
+		p.out.WriteByte('\n')
+	}
+	p.sep, p.open = false, false
+	p.writeIndent()
+}
+
+func (p *printer) writeIndent() {
+	if p.Mode&UseSpaces == 0 {
+		for i := 0; i < p.indent; i++ {
+			p.out.WriteByte('\t')
+		}
+		return
+	}
+
+	for i := 0; i < p.indent*p.Tabwidth; i++ {
+		p.out.WriteByte(' ')
+	}
+}
+
+func (p *printer) print(s ...string) {
+	for _, ss := range s {
+		p.out.WriteString(ss)
+	}
+}
+
+// separator returns the separator for inline lists.
+func (p *printer) separator() string {
+	if p.Mode&OmitCommas != 0 {
+		return " "
+	}
+	return ", "
+}
+
+// flush prints all pending docs positioned before pos and reports
+// whether any were printed. If pos is unknown, all pending docs are printed.
+//
+func (p *printer) flush(pos int64) (printed bool) {
+	for p.pending(pos) {
+		p.doc(p.docs[0])
+		p.docs = p.docs[1:]
+		printed = true
+	}
+	return
+}
+
+// pending reports whether there are pending docs positioned before pos.
+func (p *printer) pending(pos int64) bool {
+	return len(p.docs) > 0 && (pos <= 0 || p.docs[0].Char < pos)
+}
+
+// withDocs prints f with the docs of dg pending. Any docs not
+// consumed by f are printed afterwards, so none are lost.
+//
+func (p *printer) withDocs(dg *ast.DocGroup, f func()) {
+	outer := p.docs
+	p.docs = nil
+	if dg != nil {
+		p.docs = dg.List
+	}
+
+	f()
+	p.flush(0)
+	p.docs = outer
+}
+
+func (p *printer) doc(d *ast.DocGroup_Doc) {
+	if d.Comment && p.lastLine > 0 && p.line(d.Char) == p.lastLine {
+		// a trailing comment stays on the line of the node it follows
+		p.sep = false
+		p.print(" ")
+	} else {
+		p.newline(d.Char)
+	}
+	if d.Comment {
+		p.print(strings.TrimRight(d.Text, "\r\n"))
+	} else {
+		p.description(d.Text)
+	}
+
+	if n := len(d.Text); n > 0 && d.Char > 0 {
+		p.mark(d.Char + int64(n) - 1)
+	}
+}
+
+// description prints a description, which is a string literal.
+func (p *printer) description(text string) {
+	n := len(text)
+	if n >= 6 && strings.HasPrefix(text, `"""`) && strings.HasSuffix(text, `"""`) {
+		p.blockString(blockStringValue(text[3 : n-3]))
+		return
+	}
+	p.print(text)
+}
+
+// blockString prints value as a block string at the current indentation.
+func (p *printer) blockString(value string) {
+	value = strings.Replace(value, `"""`, `\"""`, -1)
+	if !strings.ContainsAny(value, "\r\n") && !strings.HasSuffix(value, `"`) && !strings.HasSuffix(value, `\`) {
+		p.print(`"""`, value, `"""`)
+		return
+	}
+
+	p.print(`"""`)
+	for _, l := range splitLines(value) {
+		p.out.WriteByte('\n')
+		if l != "" {
+			p.writeIndent()
+			p.print(l)
+		}
+	}
+	p.out.WriteByte('\n')
+	p.writeIndent()
+	p.print(`"""`)
+}
+
+// document prints the top-level docs, directives and declarations
+// of doc in source order.
+//
+func (p *printer) document(doc *ast.Document) {
+	var docs []*ast.DocGroup_Doc
+	if doc.Doc != nil {
+		docs = doc.Doc.List
+	}
+	types := doc.Types
+	if doc.Schema != nil && !contains(types, doc.Schema) {
+		types = append([]*ast.TypeDecl{doc.Schema}, types...)
+	}
+
+	// Items without position keep their place relative to
+	// the preceding item of the same kind.
+	var d, dir, t int
+	var dPos, dirPos, tPos int64
+	prev := token.UNKNOWN
+	if p.out.Len() > 0 {
+		prev = token.TYPE // e.g. the prelude
+	}
+	for d < len(docs) || dir < len(doc.Directives) || t < len(types) {
+		if d < len(docs) && docs[d].Char > 0 {
+			dPos = docs[d].Char
+		}
+		if dir < len(doc.Directives) && doc.Directives[dir].AtPos > 0 {
+			dirPos = doc.Directives[dir].AtPos
+		}
+		if t < len(types) {
+			if pos := declPos(types[t]); pos > 0 {
+				tPos = pos
+			}
+		}
+
+		switch {
+		case d < len(docs) && (dir == len(doc.Directives) || dPos <= dirPos) && (t == len(types) || dPos <= tPos):
+			p.sep = prev != token.UNKNOWN && prev != token.COMMENT
+			p.doc(docs[d])
+			prev = token.COMMENT
+			d++
+		case dir < len(doc.Directives) && (t == len(types) || dirPos <= tPos):
+			p.sep = prev != token.UNKNOWN && prev != token.AT
+			p.newline(doc.Directives[dir].AtPos)
+			p.directive(doc.Directives[dir])
+			prev = token.AT
+			dir++
+		default:
+			p.sep = prev != token.UNKNOWN
+			p.decl(types[t])
+			prev = token.TYPE
+			t++
+		}
+	}
+}
+
+// prelude prints the prelude, whose positions are recorded in its own DocSet.
+func (p *printer) prelude() {
+	pp := &printer{
+		Config: p.Config,
+		dset:   prelude.DocSet(),
+		indent: p.indent,
+	}
+	pp.document(prelude.Document())
+	p.out.Write(pp.out.Bytes())
+}
+
+func contains(types []*ast.TypeDecl, td *ast.TypeDecl) bool {
+	for _, t := range types {
+		if t == td {
+			return true
+		}
+	}
+	return false
+}
+
+// declPos returns the position of td, including its leading docs.
+func declPos(td *ast.TypeDecl) int64 {
+	if td.Doc != nil && len(td.Doc.List) > 0 && td.Doc.List[0].Char > 0 && td.Doc.List[0].Char < td.TokPos {
+		return td.Doc.List[0].Char
+	}
+	return td.TokPos
+}
+
+func (p *printer) decl(td *ast.TypeDecl) {
+	p.withDocs(td.Doc, func() {
+		if p.flush(td.TokPos) {
+			p.open = true
+		}
+		p.newline(td.TokPos)
+		p.mark(td.TokPos)
+
+		switch v := td.Spec.(type) {
+		case *ast.TypeDecl_TypeSpec:
+			p.typeSpec(td.Tok, v.TypeSpec)
+		case *ast.TypeDecl_TypeExtSpec:
+			p.print("extend ")
+			p.mark(v.TypeExtSpec.TokPos)
+			p.typeSpec(v.TypeExtSpec.Tok, v.TypeExtSpec.Type)
+		}
+	})
+}
+
+func (p *printer) typeSpec(tok token.Token, ts *ast.TypeSpec) {
+	switch v := ts.Type.(type) {
+	case *ast.TypeSpec_Schema:
+		p.print("schema")
+		p.directives(ts.Directives)
+		if v.Schema.RootOps != nil {
+			p.fieldList(v.Schema.RootOps, p.field)
+		}
+	case *ast.TypeSpec_Scalar:
+		p.print("scalar ")
+		p.ident(ts.Name)
+		p.directives(ts.Directives)
+	case *ast.TypeSpec_Object:
+		p.print("type ")
+		p.ident(ts.Name)
+		p.implements(v.Object.Interfaces)
+		p.directives(ts.Directives)
+		if v.Object.Fields != nil {
+			p.fieldList(v.Object.Fields, p.field)
+		}
+	case *ast.TypeSpec_Interface:
+		p.print("interface ")
+		p.ident(ts.Name)
+		p.implements(v.Interface.Interfaces)
+		p.directives(ts.Directives)
+		if v.Interface.Fields != nil {
+			p.fieldList(v.Interface.Fields, p.field)
+		}
+	case *ast.TypeSpec_Union:
+		p.print("union ")
+		p.ident(ts.Name)
+		p.directives(ts.Directives)
+		for i, m := range v.Union.Members {
+			if i == 0 {
+				p.print(" = ")
+			} else {
+				p.print(" | ")
+			}
+			p.ident(m)
+		}
+	case *ast.TypeSpec_Enum:
+		p.print("enum ")
+		p.ident(ts.Name)
+		p.directives(ts.Directives)
+		if v.Enum.Values != nil {
+			p.fieldList(v.Enum.Values, p.enumValue)
+		}
+	case *ast.TypeSpec_Input:
+		p.print("input ")
+		p.ident(ts.Name)
+		p.directives(ts.Directives)
+		if v.Input.Fields != nil {
+			p.inputValueList(v.Input.Fields, "{", "}")
+		}
+	case *ast.TypeSpec_Directive:
+		p.print("directive @")
+		p.ident(ts.Name)
+		if v.Directive.Args != nil {
+			p.args(v.Directive.Args)
+		}
+		if v.Directive.Repeatable {
+			p.print(" repeatable")
+		}
+		p.print(" on")
+		for i, loc := range v.Directive.Locs {
+			if i > 0 {
+				p.print(" |")
+			}
+			p.print(" ", loc.Loc.String())
+			p.mark(loc.Start)
+		}
+	default:
+		// bad AST; print what is known about it
+		p.print(strings.ToLower(tok.String()))
+		if ts.Name != nil {
+			p.print(" ")
+			p.ident(ts.Name)
+		}
+		p.directives(ts.Directives)
+	}
+}
+
+func (p *printer) implements(interfaces []*ast.Ident) {
+	for i, inter := range interfaces {
+		if i == 0 {
+			p.print(" implements ")
+		} else {
+			p.print(" & ")
+		}
+		p.ident(inter)
+	}
+}
+
+// fieldList prints a block of fields, one per line.
+func (p *printer) fieldList(fl *ast.FieldList, field func(*ast.Field)) {
+	p.print(" {")
+	p.mark(fl.Opening)
+	if len(fl.List) == 0 && !p.pending(fl.Closing) {
+		p.print("}")
+		p.mark(fl.Closing)
+		return
+	}
+
+	p.indent++
+	p.open = true
+	for _, f := range fl.List {
+		p.flush(int64(f.Pos()))
+		field(f)
+	}
+	p.flush(fl.Closing)
+	p.indent--
+
+	p.open = true
+	p.newline(fl.Closing)
+	p.print("}")
+	p.mark(fl.Closing)
+}
+
+func (p *printer) field(f *ast.Field) {
+	p.withDocs(f.Doc, func() {
+		pos := int64(f.Pos())
+		if p.flush(pos) {
+			p.open = true
+		}
+		p.newline(pos)
+
+		p.ident(f.Name)
+		if f.Args != nil {
+			p.args(f.Args)
+		}
+		p.print(": ")
+		switch v := f.Type.(type) {
+		case *ast.Field_Ident:
+			p.typ(v.Ident)
+		case *ast.Field_List:
+			p.typ(v.List)
+		case *ast.Field_NonNull:
+			p.typ(v.NonNull)
+		}
+		p.directives(f.Directives)
+	})
+}
+
+func (p *printer) enumValue(f *ast.Field) {
+	p.withDocs(f.Doc, func() {
+		pos := int64(f.Pos())
+		if p.flush(pos) {
+			p.open = true
+		}
+		p.newline(pos)
+
+		p.ident(f.Name)
+		p.directives(f.Directives)
+	})
+}
+
+// args prints argument definitions. They are printed inline,
+// unless any of them is documented.
+//
+func (p *printer) args(args *ast.InputValueList) {
+	multi := p.pending(args.Closing)
+	for _, a := range args.List {
+		if a.Doc != nil && len(a.Doc.List) > 0 {
+			multi = true
+		}
+	}
+	if multi {
+		p.inputValueList(args, "(", ")")
+		return
+	}
+	if len(args.List) == 0 {
+		return
+	}
+
+	p.print("(")
+	p.mark(args.Opening)
+	for i, a := range args.List {
+		if i > 0 {
+			p.print(p.separator())
+		}
+		p.inputValue(a)
+	}
+	p.print(")")
+	p.mark(args.Closing)
+}
+
+// inputValueList prints a block of input values, one per line.
+func (p *printer) inputValueList(l *ast.InputValueList, open, close string) {
+	if open == "{" {
+		p.print(" ")
+	}
+	p.print(open)
+	p.mark(l.Opening)
+	if len(l.List) == 0 && !p.pending(l.Closing) {
+		p.print(close)
+		p.mark(l.Closing)
+		return
+	}
+
+	p.indent++
+	p.open = true
+	for _, a := range l.List {
+		pos := int64(a.Pos())
+		p.flush(pos)
+		p.withDocs(a.Doc, func() {
+			if p.flush(pos) {
+				p.open = true
+			}
+			p.newline(pos)
+			p.inputValue(a)
+		})
+	}
+	p.flush(l.Closing)
+	p.indent--
+
+	p.open = true
+	p.newline(l.Closing)
+	p.print(close)
+	p.mark(l.Closing)
+}
+
+func (p *printer) inputValue(a *ast.InputValue) {
+	p.ident(a.Name)
+	p.print(": ")
+	switch v := a.Type.(type) {
+	case *ast.InputValue_Ident:
+		p.typ(v.Ident)
+	case *ast.InputValue_List:
+		p.typ(v.List)
+	case *ast.InputValue_NonNull:
+		p.typ(v.NonNull)
+	}
+
+	switch v := a.Default.(type) {
+	case *ast.InputValue_BasicLit:
+		p.print(" = ")
+		p.value(v.BasicLit)
+	case *ast.InputValue_CompositeLit:
+		p.print(" = ")
+		p.value(v.CompositeLit)
+	}
+	p.directives(a.Directives)
+}
+
+func (p *printer) ident(id *ast.Ident) {
+	if id == nil {
+		return
+	}
+	p.print(id.Name)
+	p.mark(id.NamePos)
+}
+
+// typ prints a type reference i.e. an *ast.Ident, *ast.List or *ast.NonNull.
+func (p *printer) typ(t interface{}) {
+	switch v := t.(type) {
+	case *ast.Ident:
+		p.ident(v)
+	case *ast.List:
+		p.print("[")
+		switch e := v.Type.(type) {
+		case *ast.List_Ident:
+			p.typ(e.Ident)
+		case *ast.List_List:
+			p.typ(e.List)
+		case *ast.List_NonNull:
+			p.typ(e.NonNull)
+		}
+		p.print("]")
+	case *ast.NonNull:
+		switch e := v.Type.(type) {
+		case *ast.NonNull_Ident:
+			p.typ(e.Ident)
+		case *ast.NonNull_List:
+			p.typ(e.List)
+		}
+		p.print("!")
+	}
+}
+
+func (p *printer) directives(directives []*ast.DirectiveLit) {
+	for _, d := range directives {
+		p.print(" ")
+		p.directive(d)
+	}
+}
+
+func (p *printer) directive(d *ast.DirectiveLit) {
+	p.print("@", d.Name)
+	p.mark(d.AtPos)
+	if d.Args == nil || len(d.Args.Args) == 0 {
+		return
+	}
+
+	p.print("(")
+	for i, a := range d.Args.Args {
+		if i > 0 {
+			p.print(p.separator())
+		}
+		p.ident(a.Name)
+		p.print(": ")
+		switch v := a.Value.(type) {
+		case *ast.Arg_BasicLit:
+			p.value(v.BasicLit)
+		case *ast.Arg_CompositeLit:
+			p.value(v.CompositeLit)
+		case *ast.Arg_Variable:
+			p.value(v.Variable)
+		}
+	}
+	p.print(")")
+	p.mark(d.Args.Rparen)
+}
+
+// value prints a value i.e. an *ast.BasicLit, *ast.CompositeLit or *ast.Variable.
+func (p *printer) value(v interface{}) {
+	switch x := v.(type) {
+	case *ast.BasicLit:
+		p.print(x.Value)
+		if n := len(x.Value); n > 0 && x.ValuePos > 0 {
+			p.mark(x.ValuePos + int64(n) - 1)
+		}
+	case *ast.Variable:
+		p.print("$")
+		p.ident(x.Name)
+	case *ast.CompositeLit:
+		switch y := x.Value.(type) {
+		case *ast.CompositeLit_BasicLit:
+			p.value(y.BasicLit)
+		case *ast.CompositeLit_Variable:
+			p.value(y.Variable)
+		case *ast.CompositeLit_ListLit:
+			p.print("[")
+			switch l := y.ListLit.List.(type) {
+			case *ast.ListLit_BasicList:
+				for i, e := range l.BasicList.Values {
+					if i > 0 {
+						p.print(p.separator())
+					}
+					p.value(e)
+				}
+			case *ast.ListLit_CompositeList:
+				for i, e := range l.CompositeList.Values {
+					if i > 0 {
+						p.print(p.separator())
+					}
+					p.value(e)
+				}
+			}
+			p.print("]")
+		case *ast.CompositeLit_ObjLit:
+			p.print("{")
+			for i, f := range y.ObjLit.Fields {
+				if i > 0 {
+					p.print(p.separator())
+				}
+				p.ident(f.Key)
+				p.print(": ")
+				p.value(f.Val)
+			}
+			p.print("}")
+		}
+		p.mark(x.Closing)
+	}
+}
+
+// blockStringValue returns the value of the raw block string contents,
+// as defined by the BlockStringValue algorithm of the GraphQL spec.
+//
+func blockStringValue(raw string) string {
+	lines := splitLines(strings.Replace(raw, `\"""`, `"""`, -1))
+
+	common := -1
+	for _, l := range lines[1:] {
+		indent := leadingWhitespace(l)
+		if indent < len(l) && (common == -1 || indent < common) {
+			common = indent
+		}
+	}
+	if common > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) < common {
+				lines[i] = ""
+				continue
+			}
+			lines[i] = lines[i][common:]
+		}
+	}
+
+	for len(lines) > 0 && leadingWhitespace(lines[0]) == len(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && leadingWhitespace(lines[len(lines)-1]) == len(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func splitLines(s string) []string {
+	s = strings.Replace(s, "\r\n", "\n", -1)
+	s = strings.Replace(s, "\r", "\n", -1)
+	return strings.Split(s, "\n")
+}
+
+func leadingWhitespace(s string) (n int) {
+	for n < len(s) && (s[n] == ' ' || s[n] == '\t') {
+		n++
+	}
+	return
+}
+
@@ -0,0 +1,501 @@
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/prelude"
+	"github.com/gqlc/graphql/token"
+)
+
+func TestFprint(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Src  string
+		Out  string
+	}{
+		{
+			Name: "Scalar",
+			Src:  `scalar   Time   @a @b(c: "d")`,
+			Out:  `scalar Time @a @b(c: "d")`,
+		},
+		{
+			Name: "Object",
+			Src: `type Test implements A&B @a {
+	a:A
+		b(c:C=1 d:[D!]!=[1,2]):B! @deprecated(reason:"no")
+}`,
+			Out: `type Test implements A & B @a {
+  a: A
+  b(c: C = 1, d: [D!]! = [1, 2]): B! @deprecated(reason: "no")
+}`,
+		},
+		{
+			Name: "Interface",
+			Src:  `interface A implements B { a: A }`,
+			Out: `interface A implements B {
+  a: A
+}`,
+		},
+		{
+			Name: "Union",
+			Src:  `union   U @a =A|B | C`,
+			Out:  `union U @a = A | B | C`,
+		},
+		{
+			Name: "Enum",
+			Src:  `enum E { A B @a, C }`,
+			Out: `enum E {
+  A
+  B @a
+  C
+}`,
+		},
+		{
+			Name: "Input",
+			Src:  `input I { a: A = {b: 1, c: [true, null], d: ENUM} b: B }`,
+			Out: `input I {
+  a: A = {b: 1, c: [true, null], d: ENUM}
+  b: B
+}`,
+		},
+		{
+			Name: "Directive",
+			Src:  `directive @a(b: B = "c") repeatable on FIELD|OBJECT`,
+			Out:  `directive @a(b: B = "c") repeatable on FIELD | OBJECT`,
+		},
+		{
+			Name: "Schema",
+			Src:  `schema @a { query: Query mutation: Mutation }`,
+			Out: `schema @a {
+  query: Query
+  mutation: Mutation
+}`,
+		},
+		{
+			Name: "Extensions",
+			Src: `extend schema @a
+extend scalar S @a
+extend type T implements I { a: A }
+extend union U = A | B
+extend enum E { A }
+extend input I @a`,
+			Out: `extend schema @a
+
+extend scalar S @a
+
+extend type T implements I {
+  a: A
+}
+
+extend union U = A | B
+
+extend enum E {
+  A
+}
+
+extend input I @a`,
+		},
+		{
+			Name: "TopLevelDirectives",
+			Src: `@a(b: 1) @c
+enum E { A }
+@d`,
+			Out: `@a(b: 1)
+@c
+
+enum E {
+  A
+}
+
+@d`,
+		},
+		{
+			Name: "Descriptions",
+			Src: `"Object"
+type T {
+		"""
+		Multi
+		  line
+		"""
+	a: A
+
+	"""   Single   """ b("arg" c: C): B
+}`,
+			Out: `"Object"
+type T {
+  """
+  Multi
+    line
+  """
+  a: A
+
+  """   Single   """
+  b(
+    "arg"
+    c: C
+  ): B
+}`,
+		},
+		{
+			Name: "BlockStringQuotes",
+			Src: `"""
+Contains \""" and ends with "
+"""
+scalar S`,
+			Out: `"""
+Contains \""" and ends with "
+"""
+scalar S`,
+		},
+		{
+			Name: "Comments",
+			Src: `# detached
+
+# leading
+type T { # first
+	a: A
+
+
+
+	# detached
+	b: B
+	# trailing
+}
+# last
+scalar S
+# eof`,
+			Out: `# detached
+
+# leading
+type T { # first
+  a: A
+
+  # detached
+  b: B
+  # trailing
+}
+
+# last
+scalar S
+
+# eof`,
+		},
+		{
+			Name: "TrailingComments",
+			Src: `enum E { A # a
+	B @d # b
+}
+type T { a(x: Int # x
+	y: Int): Int # a
+	b: Int
+} # T
+scalar S # S
+# S2`,
+			Out: `enum E {
+  A # a
+  B @d # b
+}
+
+type T {
+  a(
+    x: Int # x
+    y: Int
+  ): Int # a
+  b: Int
+} # T
+
+scalar S # S
+
+# S2`,
+		},
+		{
+			Name: "BlankLines",
+			Src: `type T {
+
+	a: A
+	b: B
+
+	c: C
+
+}
+scalar S`,
+			Out: `type T {
+  a: A
+  b: B
+
+  c: C
+}
+
+scalar S`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			out := format(subT, testCase.Src)
+			if out != testCase.Out+"\n" {
+				subT.Errorf("mismatched output:\nexpected:\n%s\ngot:\n%s", testCase.Out, out)
+				return
+			}
+
+			if again := format(subT, out); again != out {
+				subT.Errorf("output is not stable:\nfirst:\n%s\nsecond:\n%s", out, again)
+			}
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	src := `type T @a(b: [1, 2]) { a(b: B, c: C): A }`
+
+	testCases := []struct {
+		Name string
+		Cfg  Config
+		Out  string
+	}{
+		{
+			Name: "Tabs",
+			Cfg:  Config{},
+			Out: `type T @a(b: [1, 2]) {
+	a(b: B, c: C): A
+}
+`,
+		},
+		{
+			Name: "Spaces",
+			Cfg:  Config{Mode: UseSpaces, Tabwidth: 4},
+			Out: `type T @a(b: [1, 2]) {
+    a(b: B, c: C): A
+}
+`,
+		},
+		{
+			Name: "OmitCommas",
+			Cfg:  Config{Mode: UseSpaces | OmitCommas, Tabwidth: 2},
+			Out: `type T @a(b: [1 2]) {
+  a(b: B c: C): A
+}
+`,
+		},
+		{
+			Name: "Indent",
+			Cfg:  Config{Indent: 1},
+			Out: `	type T @a(b: [1, 2]) {
+		a(b: B, c: C): A
+	}
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			dset := token.NewDocSet()
+			doc, err := parser.ParseDoc(dset, "test", strings.NewReader(src), parser.ParseComments)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+
+			var b bytes.Buffer
+			err = testCase.Cfg.Fprint(&b, dset, doc)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+
+			if b.String() != testCase.Out {
+				subT.Errorf("mismatched output:\nexpected:\n%s\ngot:\n%s", testCase.Out, b.String())
+			}
+		})
+	}
+}
+
+func TestFprintPrelude(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Src  string
+		Out  string
+	}{
+		{
+			Name: "Empty",
+			Src:  ``,
+			Out:  prelude.Source,
+		},
+		{
+			Name: "Document",
+			Src:  `type T { a: Int }`,
+			Out: prelude.Source + `
+type T {
+  a: Int
+}
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			dset := token.NewDocSet()
+			doc, err := parser.ParseDoc(dset, "test", strings.NewReader(testCase.Src), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			var b bytes.Buffer
+			cfg := &Config{Mode: UseSpaces | Prelude, Tabwidth: 2}
+			if err = cfg.Fprint(&b, dset, doc); err != nil {
+				subT.Fatal(err)
+			}
+
+			if b.String() != testCase.Out {
+				subT.Errorf("mismatched output:\nexpected:\n%s\ngot:\n%s", testCase.Out, b.String())
+			}
+		})
+	}
+}
+
+func TestFprintWithoutPositions(t *testing.T) {
+	doc := &ast.Document{
+		Doc: &ast.DocGroup{List: []*ast.DocGroup_Doc{{Text: "# generated\n", Comment: true}}},
+		Types: []*ast.TypeDecl{
+			{
+				Doc: &ast.DocGroup{List: []*ast.DocGroup_Doc{{Text: "\"\"\"\nA \"test\" object.\nSpans lines.\n\"\"\""}}},
+				Tok: token.TYPE,
+				Spec: &ast.TypeDecl_TypeSpec{TypeSpec: &ast.TypeSpec{
+					Name: &ast.Ident{Name: "Test"},
+					Type: &ast.TypeSpec_Object{Object: &ast.ObjectType{
+						Fields: &ast.FieldList{List: []*ast.Field{
+							{
+								Doc:  &ast.DocGroup{List: []*ast.DocGroup_Doc{{Text: `"A\tfield"`}}},
+								Name: &ast.Ident{Name: "a"},
+								Type: &ast.Field_NonNull{NonNull: &ast.NonNull{
+									Type: &ast.NonNull_Ident{Ident: &ast.Ident{Name: "String"}},
+								}},
+							},
+						}},
+					}},
+				}},
+			},
+		},
+	}
+
+	var b bytes.Buffer
+	err := Fprint(&b, nil, doc)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ex := `# generated
+
+"""
+A "test" object.
+Spans lines.
+"""
+type Test {
+  "A\tfield"
+  a: String!
+}
+`
+	if b.String() != ex {
+		t.Errorf("mismatched output:\nexpected:\n%s\ngot:\n%s", ex, b.String())
+	}
+}
+
+func TestFprintTestdata(t *testing.T) {
+	src, err := ioutil.ReadFile("../parser/testdir/test.gql")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	out := format(t, string(src))
+	if again := format(t, out); again != out {
+		t.Errorf("output is not stable:\nfirst:\n%s\nsecond:\n%s", out, again)
+	}
+}
+
+func TestFprintUnsupported(t *testing.T) {
+	err := Fprint(ioutil.Discard, nil, &ast.Ident{Name: "a"})
+	if err == nil {
+		t.Error("expected error for unsupported node")
+	}
+}
+
+func TestFprintCommentOwners(t *testing.T) {
+	src := `# E
+enum E { # A
+	A # A
+	B @d # B
+	# C
+	C
+} # E
+type T { a( # x
+	x: Int # x
+	y: Int = 1 # y
+): Int # a
+	b: Int # b
+}
+scalar S # S
+`
+
+	// owners maps each comment to the name of the node it's attached to.
+	owners := func(src string) map[string][]string {
+		doc, err := parser.ParseDoc(token.NewDocSet(), "test", strings.NewReader(src), parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := make(map[string][]string)
+		add := func(name string, dg *ast.DocGroup) {
+			for _, d := range dg.GetList() {
+				if d.Comment {
+					m[name] = append(m[name], strings.TrimSpace(d.Text))
+				}
+			}
+		}
+		for _, td := range doc.Types {
+			ts := td.GetTypeSpec()
+			add(ts.Name.Name, td.Doc)
+
+			fields := ts.GetObject().GetFields().GetList()
+			fields = append(fields, ts.GetEnum().GetValues().GetList()...)
+			for _, f := range fields {
+				add(ts.Name.Name+"."+f.Name.Name, f.Doc)
+				for _, a := range f.GetArgs().GetList() {
+					add(ts.Name.Name+"."+f.Name.Name+"("+a.Name.Name+":)", a.Doc)
+				}
+			}
+		}
+		return m
+	}
+
+	out := format(t, src)
+	ex, got := owners(src), owners(out)
+	if fmt.Sprint(ex) != fmt.Sprint(got) {
+		t.Errorf("mismatched comment owners:\nexpected: %v\ngot: %v\noutput:\n%s", ex, got, out)
+	}
+	if ex["E.A"] == nil || ex["E.B"] == nil || ex["E"] == nil || ex["T.a(y:)"] == nil || ex["T.b"] == nil || ex["S"] == nil {
+		t.Errorf("expected trailing comments to be attached to the nodes they follow: %v", ex)
+	}
+}
+
+func format(t *testing.T, src string) string {
+	dset := token.NewDocSet()
+	doc, err := parser.ParseDoc(dset, "test", strings.NewReader(src), parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	err = Fprint(&b, dset, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
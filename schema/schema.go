@@ -0,0 +1,429 @@
+// Package schema provides a resolved model of a GraphQL schema, in which
+// the types, fields, directives and root operation types declared by a set
+// of ast.Documents are linked to each other.
+//
+// A Schema is immutable once built. Every part of it keeps a reference
+// to the AST node it was built from.
+//
+package schema
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/prelude"
+	"github.com/gqlc/graphql/token"
+	"github.com/gqlc/graphql/validate"
+)
+
+// Schema is a resolved GraphQL schema.
+type Schema struct {
+	description string
+	node        *ast.TypeSpec
+	directives  []*AppliedDirective
+
+	query        *Type
+	mutation     *Type
+	subscription *Type
+
+	types         map[string]*Type
+	typeList      []*Type
+	directiveDefs map[string]*Directive
+	directiveList []*Directive
+}
+
+// Description returns the description of the schema definition, if any.
+func (s *Schema) Description() string { return s.description }
+
+// Node returns the schema definition, or nil if the
+// root operation types are implied by their names.
+//
+func (s *Schema) Node() *ast.TypeSpec { return s.node }
+
+// Directives returns the directives applied to the schema definition and its extensions.
+func (s *Schema) Directives() []*AppliedDirective { return s.directives }
+
+// QueryType returns the query root operation type.
+func (s *Schema) QueryType() *Type { return s.query }
+
+// MutationType returns the mutation root operation type, or nil.
+func (s *Schema) MutationType() *Type { return s.mutation }
+
+// SubscriptionType returns the subscription root operation type, or nil.
+func (s *Schema) SubscriptionType() *Type { return s.subscription }
+
+// Type returns the named type, or nil if the schema has no such type.
+func (s *Schema) Type(name string) *Type { return s.types[name] }
+
+// Types returns every named type of the schema, including the built-in
+// scalars and, if the schema was built in Prelude mode, the introspection
+// types, sorted by name.
+//
+func (s *Schema) Types() []*Type { return s.typeList }
+
+// DirectiveDefinition returns the named directive definition, or nil.
+func (s *Schema) DirectiveDefinition(name string) *Directive { return s.directiveDefs[name] }
+
+// DirectiveDefinitions returns every directive definition of
+// the schema, including the built-in directives, sorted by name.
+//
+func (s *Schema) DirectiveDefinitions() []*Directive { return s.directiveList }
+
+// Mode represents a building mode.
+type Mode uint
+
+// Mode Options
+const (
+	Prelude Mode = 1 << iota // include the introspection types of the prelude
+)
+
+// A Config controls the behaviour of New.
+type Config struct {
+	Mode Mode // default: 0
+}
+
+// New builds the schema declared by the given documents. The documents are
+// validated first; if they are invalid, the error is a validate.ErrorList.
+//
+// The built-in scalars and directives are resolved from the prelude,
+// unless the documents redefine them. If the Prelude mode is set, the
+// introspection types are part of the schema, too.
+//
+func (cfg *Config) New(dset *token.DocSet, docs ...*ast.Document) (*Schema, error) {
+	vcfg := &validate.Config{}
+	if cfg.Mode&Prelude != 0 {
+		vcfg.Mode = validate.Prelude
+	}
+	if err := vcfg.Validate(dset, docs...); err != nil {
+		return nil, err
+	}
+
+	b := &builder{
+		c:     vcfg.NewContext(dset, docs...),
+		decls: make(map[*ast.TypeSpec]*ast.TypeDecl),
+		s: &Schema{
+			types:         make(map[string]*Type),
+			directiveDefs: make(map[string]*Directive),
+		},
+	}
+	for _, doc := range append([]*ast.Document{prelude.Document()}, docs...) {
+		for _, td := range doc.Types {
+			if ts := td.GetTypeSpec(); ts != nil {
+				b.decls[ts] = td
+			}
+		}
+	}
+
+	b.declare()
+	b.link()
+	b.roots()
+	return b.s, nil
+}
+
+// New builds the schema declared by the given documents.
+// It is equivalent to calling New on a zero Config.
+//
+func New(dset *token.DocSet, docs ...*ast.Document) (*Schema, error) {
+	return new(Config).New(dset, docs...)
+}
+
+type builder struct {
+	c     *validate.Context
+	decls map[*ast.TypeSpec]*ast.TypeDecl
+	s     *Schema
+}
+
+// declare creates every type and directive, so they may be referenced by link.
+func (b *builder) declare() {
+	for name, ts := range b.c.Types {
+		t := &Type{
+			name:        name,
+			kind:        kindOf(ts),
+			description: description(b.decls[ts].GetDoc(), b.decls[ts].Pos()),
+			node:        ts,
+			extensions:  b.c.Extensions[name],
+		}
+		b.s.types[name] = t
+		b.s.typeList = append(b.s.typeList, t)
+	}
+	sort.Slice(b.s.typeList, func(i, j int) bool { return b.s.typeList[i].name < b.s.typeList[j].name })
+
+	for name, ts := range b.c.Directives {
+		d := &Directive{
+			name:        name,
+			description: description(b.decls[ts].GetDoc(), b.decls[ts].Pos()),
+			node:        ts,
+		}
+		b.s.directiveDefs[name] = d
+		b.s.directiveList = append(b.s.directiveList, d)
+	}
+	sort.Slice(b.s.directiveList, func(i, j int) bool { return b.s.directiveList[i].name < b.s.directiveList[j].name })
+}
+
+// link resolves the contents of every type and directive.
+func (b *builder) link() {
+	for _, d := range b.s.directiveList {
+		def := d.node.GetDirective()
+		d.args = b.inputValues(def.Args)
+		d.repeatable = def.Repeatable
+		for _, l := range def.Locs {
+			d.locations = append(d.locations, l.Loc)
+		}
+	}
+
+	for _, t := range b.s.typeList {
+		for _, ts := range b.c.Specs(t.name) {
+			t.directives = append(t.directives, b.directives(ts.Directives)...)
+
+			switch v := ts.Type.(type) {
+			case *ast.TypeSpec_Object:
+				t.interfaces = append(t.interfaces, b.types(v.Object.Interfaces)...)
+				t.fields = append(t.fields, b.fields(t, v.Object.Fields)...)
+			case *ast.TypeSpec_Interface:
+				t.interfaces = append(t.interfaces, b.types(v.Interface.Interfaces)...)
+				t.fields = append(t.fields, b.fields(t, v.Interface.Fields)...)
+			case *ast.TypeSpec_Union:
+				t.possibleTypes = append(t.possibleTypes, b.types(v.Union.Members)...)
+			case *ast.TypeSpec_Enum:
+				for _, f := range v.Enum.Values.GetList() {
+					t.enumValues = append(t.enumValues, &EnumValue{
+						name:        f.Name.Name,
+						description: description(f.Doc, f.Name.Pos()),
+						directives:  b.directives(f.Directives),
+						node:        f,
+					})
+				}
+			case *ast.TypeSpec_Input:
+				t.inputFields = append(t.inputFields, b.inputValues(v.Input.Fields)...)
+			}
+		}
+	}
+
+	for _, t := range b.s.typeList {
+		if t.kind == Union {
+			sort.Slice(t.possibleTypes, func(i, j int) bool { return t.possibleTypes[i].name < t.possibleTypes[j].name })
+		}
+		if t.kind != Object {
+			continue
+		}
+		for _, iface := range t.interfaces {
+			iface.possibleTypes = append(iface.possibleTypes, t)
+		}
+	}
+}
+
+// roots resolves the root operation types of the schema.
+func (b *builder) roots() {
+	var decls []*ast.TypeDecl
+	if len(b.c.Schemas) > 0 {
+		decls = append(decls, b.c.Schemas[0])
+		b.s.node = b.c.Schemas[0].GetTypeSpec()
+		b.s.description = description(b.c.Schemas[0].Doc, b.c.Schemas[0].Pos())
+	}
+	decls = append(decls, b.c.SchemaExtensions...)
+
+	if len(decls) == 0 {
+		b.s.query = b.s.types["Query"]
+		b.s.mutation = b.s.types["Mutation"]
+		b.s.subscription = b.s.types["Subscription"]
+		return
+	}
+
+	for _, td := range decls {
+		ts := td.GetTypeSpec()
+		if ts == nil {
+			ts = td.GetTypeExtSpec().Type
+		}
+		b.s.directives = append(b.s.directives, b.directives(ts.Directives)...)
+
+		for _, f := range ts.GetSchema().GetRootOps().GetList() {
+			t := b.s.types[f.GetIdent().GetName()]
+			switch f.Name.Name {
+			case "query":
+				b.s.query = t
+			case "mutation":
+				b.s.mutation = t
+			case "subscription":
+				b.s.subscription = t
+			}
+		}
+	}
+}
+
+func (b *builder) types(ids []*ast.Ident) (types []*Type) {
+	for _, id := range ids {
+		types = append(types, b.s.types[id.Name])
+	}
+	return
+}
+
+func (b *builder) fields(parent *Type, l *ast.FieldList) (fields []*Field) {
+	for _, f := range l.GetList() {
+		fields = append(fields, &Field{
+			name:        f.Name.Name,
+			description: description(f.Doc, f.Name.Pos()),
+			parent:      parent,
+			args:        b.inputValues(f.Args),
+			typ:         b.typeRef(f.Type),
+			directives:  b.directives(f.Directives),
+			node:        f,
+		})
+	}
+	return
+}
+
+func (b *builder) inputValues(l *ast.InputValueList) (vals []*InputValue) {
+	for _, a := range l.GetList() {
+		v := &InputValue{
+			name:        a.Name.Name,
+			description: description(a.Doc, a.Name.Pos()),
+			typ:         b.typeRef(a.Type),
+			directives:  b.directives(a.Directives),
+			node:        a,
+		}
+		switch d := a.Default.(type) {
+		case *ast.InputValue_BasicLit:
+			v.defaultValue = d.BasicLit
+		case *ast.InputValue_CompositeLit:
+			v.defaultValue = d.CompositeLit
+		}
+		vals = append(vals, v)
+	}
+	return
+}
+
+func (b *builder) directives(dirs []*ast.DirectiveLit) (applied []*AppliedDirective) {
+	for _, d := range dirs {
+		a := &AppliedDirective{
+			name:       d.Name,
+			definition: b.s.directiveDefs[d.Name],
+			node:       d,
+		}
+		for _, arg := range d.Args.GetArgs() {
+			var val ast.Node
+			switch v := arg.Value.(type) {
+			case *ast.Arg_BasicLit:
+				val = v.BasicLit
+			case *ast.Arg_CompositeLit:
+				val = v.CompositeLit
+			case *ast.Arg_Variable:
+				val = v.Variable
+			}
+			a.args = append(a.args, &Argument{name: arg.Name.Name, value: val, node: arg})
+		}
+		applied = append(applied, a)
+	}
+	return
+}
+
+// typeRef resolves the type of a field or input value.
+func (b *builder) typeRef(typ interface{}) TypeRef {
+	switch v := typ.(type) {
+	case *ast.Field_Ident:
+		return b.s.types[v.Ident.Name]
+	case *ast.Field_List:
+		return &List{ofType: b.typeRef(v.List.Type)}
+	case *ast.Field_NonNull:
+		return &NonNull{ofType: b.typeRef(v.NonNull.Type)}
+	case *ast.InputValue_Ident:
+		return b.s.types[v.Ident.Name]
+	case *ast.InputValue_List:
+		return &List{ofType: b.typeRef(v.List.Type)}
+	case *ast.InputValue_NonNull:
+		return &NonNull{ofType: b.typeRef(v.NonNull.Type)}
+	case *ast.List_Ident:
+		return b.s.types[v.Ident.Name]
+	case *ast.List_List:
+		return &List{ofType: b.typeRef(v.List.Type)}
+	case *ast.List_NonNull:
+		return &NonNull{ofType: b.typeRef(v.NonNull.Type)}
+	case *ast.NonNull_Ident:
+		return b.s.types[v.Ident.Name]
+	case *ast.NonNull_List:
+		return &List{ofType: b.typeRef(v.List.Type)}
+	}
+	return nil
+}
+
+func kindOf(ts *ast.TypeSpec) Kind {
+	switch ts.Type.(type) {
+	case *ast.TypeSpec_Scalar:
+		return Scalar
+	case *ast.TypeSpec_Object:
+		return Object
+	case *ast.TypeSpec_Interface:
+		return Interface
+	case *ast.TypeSpec_Union:
+		return Union
+	case *ast.TypeSpec_Enum:
+		return Enum
+	case *ast.TypeSpec_Input:
+		return InputObject
+	}
+	return 0
+}
+
+// description returns the value of the description preceding pos in dg, if any.
+func description(dg *ast.DocGroup, pos token.Pos) string {
+	var text string
+	for _, d := range dg.GetList() {
+		if !d.Comment && d.Pos() < pos {
+			text = d.Text
+		}
+	}
+	return StringValue(text)
+}
+
+// StringValue returns the value of the string literal s, which may be
+// a block string e.g. the Value of an *ast.BasicLit of kind STRING or
+// the Text of a description.
+//
+func StringValue(s string) string {
+	n := len(s)
+	if n >= 6 && strings.HasPrefix(s, `"""`) && strings.HasSuffix(s, `"""`) {
+		return blockStringValue(s[3 : n-3])
+	}
+	if n < 2 {
+		return ""
+	}
+	v, err := strconv.Unquote(strings.Replace(s, `\/`, "/", -1))
+	if err != nil {
+		return s[1 : n-1]
+	}
+	return v
+}
+
+// blockStringValue returns the value of the raw contents of a block
+// string i.e. without common indentation and surrounding blank lines.
+//
+func blockStringValue(raw string) string {
+	raw = strings.Replace(raw, `\"""`, `"""`, -1)
+	lines := strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(raw), "\n")
+
+	indent := -1
+	for _, l := range lines[1:] {
+		n := len(l) - len(strings.TrimLeft(l, " \t"))
+		if n < len(l) && (indent < 0 || n < indent) {
+			indent = n
+		}
+	}
+	if indent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) < indent {
+				lines[i] = ""
+				continue
+			}
+			lines[i] = lines[i][indent:]
+		}
+	}
+
+	for len(lines) > 0 && strings.TrimLeft(lines[0], " \t") == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimLeft(lines[len(lines)-1], " \t") == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,285 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/prelude"
+	"github.com/gqlc/graphql/token"
+	"github.com/gqlc/graphql/validate"
+)
+
+const testSrc = `"""
+  The schema.
+"""
+schema @tag(name: "s") { query: Root, mutation: Mutation }
+
+"The root \"query\" type."
+type Root implements Node {
+	id: ID!
+
+	"Searches for things."
+	search(
+		"The search term."
+		term: String!,
+		kinds: [Kind!] = [A]
+	): [Result!]! @tag(name: "f")
+}
+
+type Mutation { add(in: Input!): Node }
+
+extend type Mutation { remove(id: ID!): Boolean @deprecated }
+
+interface Node { id: ID! }
+
+type Thing implements Node { id: ID!, kind: Kind }
+
+union Result = Thing | Root
+
+enum Kind { A @tag(name: "v"), B }
+
+extend enum Kind { C }
+
+input Input { name: String = "x", kind: Kind }
+
+scalar Time
+
+directive @tag(name: String!) repeatable on SCHEMA | FIELD_DEFINITION | ENUM_VALUE
+`
+
+func parse(t *testing.T, srcs ...string) (*token.DocSet, []*ast.Document) {
+	dset := token.NewDocSet()
+	docs := make([]*ast.Document, len(srcs))
+	for i, src := range srcs {
+		doc, err := parser.ParseDoc(dset, "test", strings.NewReader(src), parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		docs[i] = doc
+	}
+	return dset, docs
+}
+
+func TestNew(t *testing.T) {
+	dset, docs := parse(t, testSrc)
+	s, err := New(dset, docs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Roots", func(subT *testing.T) {
+		if s.QueryType() != s.Type("Root") {
+			subT.Errorf("expected query type Root, got: %v", s.QueryType())
+		}
+		if s.MutationType() != s.Type("Mutation") {
+			subT.Errorf("expected mutation type Mutation, got: %v", s.MutationType())
+		}
+		if s.SubscriptionType() != nil {
+			subT.Errorf("expected no subscription type, got: %v", s.SubscriptionType())
+		}
+		if s.Description() != "The schema." {
+			subT.Errorf("unexpected schema description: %q", s.Description())
+		}
+		if len(s.Directives()) != 1 || s.Directives()[0].Definition() != s.DirectiveDefinition("tag") {
+			subT.Errorf("expected schema to be tagged")
+		}
+	})
+
+	t.Run("Types", func(subT *testing.T) {
+		var names []string
+		for _, typ := range s.Types() {
+			names = append(names, typ.Name()+":"+typ.Kind().String())
+		}
+
+		expected := "Boolean:SCALAR Float:SCALAR ID:SCALAR Input:INPUT_OBJECT Int:SCALAR Kind:ENUM Mutation:OBJECT Node:INTERFACE Result:UNION Root:OBJECT String:SCALAR Thing:OBJECT Time:SCALAR"
+		if got := strings.Join(names, " "); got != expected {
+			subT.Errorf("expected types:\n%s\ngot:\n%s", expected, got)
+		}
+
+		if s.Type("String").Node() != prelude.Document().Types[2].GetTypeSpec() {
+			subT.Error("expected built-in scalar to reference the prelude")
+		}
+		if s.Type("String").Description() == "" {
+			subT.Error("expected built-in scalar to have a description")
+		}
+		if s.Type("Time").Node() != docs[0].Types[10].GetTypeSpec() {
+			subT.Error("expected Time to reference its definition")
+		}
+	})
+
+	t.Run("Fields", func(subT *testing.T) {
+		root := s.Type("Root")
+		if root.Description() != `The root "query" type.` {
+			subT.Errorf("unexpected description: %q", root.Description())
+		}
+
+		search := root.Field("search")
+		if search == nil {
+			subT.Fatal("expected field search")
+		}
+		if search.Parent() != root {
+			subT.Error("expected field parent to be Root")
+		}
+		if search.Description() != "Searches for things." {
+			subT.Errorf("unexpected description: %q", search.Description())
+		}
+		if search.Type().String() != "[Result!]!" {
+			subT.Errorf("unexpected type: %s", search.Type())
+		}
+		if search.Type().NamedType() != s.Type("Result") {
+			subT.Error("expected named type to be Result")
+		}
+
+		list := search.Type().(*NonNull).OfType().(*List)
+		if list.OfType().(*NonNull).OfType() != s.Type("Result") {
+			subT.Error("expected list of Result")
+		}
+
+		term := search.Arg("term")
+		if term.Description() != "The search term." || term.Type().String() != "String!" || term.DefaultValue() != nil {
+			subT.Errorf("unexpected argument term: %q %s", term.Description(), term.Type())
+		}
+		kinds := search.Arg("kinds")
+		if _, ok := kinds.DefaultValue().(*ast.CompositeLit); !ok {
+			subT.Errorf("expected composite default value, got: %T", kinds.DefaultValue())
+		}
+
+		tag := search.Directives()[0]
+		if tag.Name() != "tag" || tag.Definition() != s.DirectiveDefinition("tag") {
+			subT.Errorf("unexpected directive: %s", tag.Name())
+		}
+		if v, ok := tag.Arg("name").Value().(*ast.BasicLit); !ok || v.Value != `"f"` {
+			subT.Errorf("unexpected directive argument: %v", tag.Arg("name").Value())
+		}
+
+		var names []string
+		for _, f := range s.Type("Mutation").Fields() {
+			names = append(names, f.Name())
+		}
+		if strings.Join(names, " ") != "add remove" {
+			subT.Errorf("expected fields from extension, got: %v", names)
+		}
+
+		deprecated := s.Type("Mutation").Field("remove").Directives()[0]
+		if deprecated.Name() != "deprecated" || deprecated.Definition() != s.DirectiveDefinition("deprecated") {
+			subT.Error("expected built-in directive to be defined by the prelude")
+		}
+		if reason := deprecated.Definition().Arg("reason"); reason.DefaultValue().(*ast.BasicLit).Value != `"No longer supported"` {
+			subT.Errorf("unexpected default reason: %v", reason.DefaultValue())
+		}
+		if len(s.Type("Mutation").Extensions()) != 1 {
+			subT.Error("expected Mutation to have an extension")
+		}
+	})
+
+	t.Run("PossibleTypes", func(subT *testing.T) {
+		node := s.Type("Node")
+		if got := typeNames(node.PossibleTypes()); got != "Root Thing" {
+			subT.Errorf("unexpected implementations: %s", got)
+		}
+		if got := typeNames(s.Type("Result").PossibleTypes()); got != "Root Thing" {
+			subT.Errorf("unexpected members: %s", got)
+		}
+		if got := typeNames(s.Type("Thing").Interfaces()); got != "Node" {
+			subT.Errorf("unexpected interfaces: %s", got)
+		}
+	})
+
+	t.Run("EnumValues", func(subT *testing.T) {
+		var names []string
+		for _, v := range s.Type("Kind").EnumValues() {
+			names = append(names, v.Name())
+		}
+		if strings.Join(names, " ") != "A B C" {
+			subT.Errorf("unexpected enum values: %v", names)
+		}
+		if len(s.Type("Kind").EnumValues()[0].Directives()) != 1 {
+			subT.Error("expected enum value A to be tagged")
+		}
+	})
+
+	t.Run("InputFields", func(subT *testing.T) {
+		in := s.Type("Input")
+		if in.InputField("kind").Type() != s.Type("Kind") {
+			subT.Error("expected input field of type Kind")
+		}
+		if v, ok := in.InputField("name").DefaultValue().(*ast.BasicLit); !ok || v.Value != `"x"` {
+			subT.Errorf("unexpected default value: %v", in.InputField("name").DefaultValue())
+		}
+	})
+
+	t.Run("Directives", func(subT *testing.T) {
+		tag := s.DirectiveDefinition("tag")
+		if !tag.IsRepeatable() || len(tag.Locations()) != 3 || tag.Arg("name").Type().String() != "String!" {
+			subT.Errorf("unexpected directive definition: %v", tag.Node())
+		}
+
+		var names []string
+		for _, d := range s.DirectiveDefinitions() {
+			names = append(names, d.Name())
+		}
+		if got := strings.Join(names, " "); got != "deprecated include skip specifiedBy tag" {
+			subT.Errorf("unexpected directive definitions: %s", got)
+		}
+	})
+}
+
+func TestNewImpliedRoots(t *testing.T) {
+	dset, docs := parse(t, `type Query { a: Int }`, `type Subscription { b: Int }`)
+	s, err := New(dset, docs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Node() != nil {
+		t.Error("expected no schema definition")
+	}
+	if s.QueryType() != s.Type("Query") || s.MutationType() != nil || s.SubscriptionType() != s.Type("Subscription") {
+		t.Error("unexpected root operation types")
+	}
+}
+
+func TestConfig(t *testing.T) {
+	dset, docs := parse(t, `type Query { a: Int }`)
+	s, err := (&Config{Mode: Prelude}).New(dset, docs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typ := s.Type("__Type")
+	if typ == nil || typ.Kind() != Object {
+		t.Fatal("expected introspection type __Type")
+	}
+	if f := typ.Field("fields"); f.Type().String() != "[__Field!]" || f.Type().NamedType() != s.Type("__Field") {
+		t.Errorf("unexpected field __Type.fields: %s", f.Type())
+	}
+	if got := len(s.Type("__TypeKind").EnumValues()); got != 8 {
+		t.Errorf("expected 8 kinds, got: %d", got)
+	}
+
+	s, err = New(dset, docs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Type("__Type") != nil {
+		t.Error("expected no introspection types")
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	dset, docs := parse(t, `type Query { a: Undefined }`)
+	_, err := New(dset, docs...)
+	if _, ok := err.(validate.ErrorList); !ok {
+		t.Fatalf("expected validate.ErrorList, got: %v", err)
+	}
+}
+
+func typeNames(types []*Type) string {
+	var names []string
+	for _, t := range types {
+		names = append(names, t.Name())
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,322 @@
+package schema
+
+import "github.com/gqlc/graphql/ast"
+
+// Kind describes the kind of a named type.
+type Kind int
+
+// The kinds of named types.
+const (
+	Scalar Kind = iota + 1
+	Object
+	Interface
+	Union
+	Enum
+	InputObject
+)
+
+var kinds = [...]string{
+	Scalar:      "SCALAR",
+	Object:      "OBJECT",
+	Interface:   "INTERFACE",
+	Union:       "UNION",
+	Enum:        "ENUM",
+	InputObject: "INPUT_OBJECT",
+}
+
+// String returns the name of the kind as used by introspection e.g. INPUT_OBJECT.
+func (k Kind) String() string {
+	if 0 < k && int(k) < len(kinds) {
+		return kinds[k]
+	}
+	return ""
+}
+
+// A TypeRef is a reference to a type, as used for the type of a field or
+// an argument. It is either a named *Type, a *List or a *NonNull.
+//
+type TypeRef interface {
+	// String returns the reference as it would be written in a document e.g. [Int!]!.
+	String() string
+
+	// NamedType returns the named type at the core of the reference
+	// i.e. without any List and NonNull wrappers.
+	//
+	NamedType() *Type
+}
+
+// List is a list of the type it wraps.
+type List struct {
+	ofType TypeRef
+}
+
+// OfType returns the type of the elements of the list.
+func (l *List) OfType() TypeRef { return l.ofType }
+
+// String implements TypeRef.
+func (l *List) String() string { return "[" + l.ofType.String() + "]" }
+
+// NamedType implements TypeRef.
+func (l *List) NamedType() *Type { return l.ofType.NamedType() }
+
+// NonNull is a non-null version of the type it wraps.
+type NonNull struct {
+	ofType TypeRef
+}
+
+// OfType returns the wrapped, nullable type.
+func (n *NonNull) OfType() TypeRef { return n.ofType }
+
+// String implements TypeRef.
+func (n *NonNull) String() string { return n.ofType.String() + "!" }
+
+// NamedType implements TypeRef.
+func (n *NonNull) NamedType() *Type { return n.ofType.NamedType() }
+
+// A Type is a named type of a schema.
+type Type struct {
+	name        string
+	kind        Kind
+	description string
+	node        *ast.TypeSpec
+	extensions  []*ast.TypeSpec
+	directives  []*AppliedDirective
+
+	fields        []*Field
+	interfaces    []*Type
+	possibleTypes []*Type
+	enumValues    []*EnumValue
+	inputFields   []*InputValue
+}
+
+// Name returns the name of the type.
+func (t *Type) Name() string { return t.name }
+
+// Kind returns the kind of the type.
+func (t *Type) Kind() Kind { return t.kind }
+
+// Description returns the description of the type, if any.
+func (t *Type) Description() string { return t.description }
+
+// Node returns the definition of the type. The definition of a built-in
+// type is declared by the prelude, unless a document redefines it.
+//
+func (t *Type) Node() *ast.TypeSpec { return t.node }
+
+// Extensions returns the extensions of the type, in document order.
+func (t *Type) Extensions() []*ast.TypeSpec { return t.extensions }
+
+// Directives returns the directives applied to the type and its extensions.
+func (t *Type) Directives() []*AppliedDirective { return t.directives }
+
+// Fields returns the fields of an object or interface, in declaration order.
+func (t *Type) Fields() []*Field { return t.fields }
+
+// Field returns the named field of an object or interface, or nil.
+func (t *Type) Field(name string) *Field {
+	for _, f := range t.fields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// Interfaces returns the interfaces implemented by an object or interface.
+func (t *Type) Interfaces() []*Type { return t.interfaces }
+
+// PossibleTypes returns the members of a union, or the object
+// types implementing an interface, sorted by name.
+//
+func (t *Type) PossibleTypes() []*Type { return t.possibleTypes }
+
+// EnumValues returns the values of an enum, in declaration order.
+func (t *Type) EnumValues() []*EnumValue { return t.enumValues }
+
+// InputFields returns the fields of an input object, in declaration order.
+func (t *Type) InputFields() []*InputValue { return t.inputFields }
+
+// InputField returns the named field of an input object, or nil.
+func (t *Type) InputField(name string) *InputValue { return lookupInputValue(t.inputFields, name) }
+
+// String implements TypeRef.
+func (t *Type) String() string { return t.name }
+
+// NamedType implements TypeRef.
+func (t *Type) NamedType() *Type { return t }
+
+// A Field is a field of an object or interface.
+type Field struct {
+	name        string
+	description string
+	parent      *Type
+	args        []*InputValue
+	typ         TypeRef
+	directives  []*AppliedDirective
+	node        *ast.Field
+}
+
+// Name returns the name of the field.
+func (f *Field) Name() string { return f.name }
+
+// Description returns the description of the field, if any.
+func (f *Field) Description() string { return f.description }
+
+// Parent returns the object or interface declaring the field.
+func (f *Field) Parent() *Type { return f.parent }
+
+// Args returns the arguments of the field, in declaration order.
+func (f *Field) Args() []*InputValue { return f.args }
+
+// Arg returns the named argument of the field, or nil.
+func (f *Field) Arg(name string) *InputValue { return lookupInputValue(f.args, name) }
+
+// Type returns the type of the field.
+func (f *Field) Type() TypeRef { return f.typ }
+
+// Directives returns the directives applied to the field.
+func (f *Field) Directives() []*AppliedDirective { return f.directives }
+
+// Node returns the declaration of the field.
+func (f *Field) Node() *ast.Field { return f.node }
+
+// An InputValue is an argument of a field or directive, or a field of an input object.
+type InputValue struct {
+	name         string
+	description  string
+	typ          TypeRef
+	defaultValue ast.Node
+	directives   []*AppliedDirective
+	node         *ast.InputValue
+}
+
+// Name returns the name of the input value.
+func (v *InputValue) Name() string { return v.name }
+
+// Description returns the description of the input value, if any.
+func (v *InputValue) Description() string { return v.description }
+
+// Type returns the type of the input value.
+func (v *InputValue) Type() TypeRef { return v.typ }
+
+// DefaultValue returns the literal default value i.e. an *ast.BasicLit or
+// *ast.CompositeLit, or nil if the input value has no default value.
+//
+func (v *InputValue) DefaultValue() ast.Node { return v.defaultValue }
+
+// Directives returns the directives applied to the input value.
+func (v *InputValue) Directives() []*AppliedDirective { return v.directives }
+
+// Node returns the declaration of the input value.
+func (v *InputValue) Node() *ast.InputValue { return v.node }
+
+// An EnumValue is a value of an enum.
+type EnumValue struct {
+	name        string
+	description string
+	directives  []*AppliedDirective
+	node        *ast.Field
+}
+
+// Name returns the name of the enum value.
+func (v *EnumValue) Name() string { return v.name }
+
+// Description returns the description of the enum value, if any.
+func (v *EnumValue) Description() string { return v.description }
+
+// Directives returns the directives applied to the enum value.
+func (v *EnumValue) Directives() []*AppliedDirective { return v.directives }
+
+// Node returns the declaration of the enum value.
+func (v *EnumValue) Node() *ast.Field { return v.node }
+
+// A Directive is a directive definition.
+type Directive struct {
+	name        string
+	description string
+	args        []*InputValue
+	locations   []ast.DirectiveLocation_Loc
+	repeatable  bool
+	node        *ast.TypeSpec
+}
+
+// Name returns the name of the directive, without the leading @.
+func (d *Directive) Name() string { return d.name }
+
+// Description returns the description of the directive, if any.
+func (d *Directive) Description() string { return d.description }
+
+// Args returns the arguments of the directive, in declaration order.
+func (d *Directive) Args() []*InputValue { return d.args }
+
+// Arg returns the named argument of the directive, or nil.
+func (d *Directive) Arg(name string) *InputValue { return lookupInputValue(d.args, name) }
+
+// Locations returns the locations in which the directive may be applied.
+func (d *Directive) Locations() []ast.DirectiveLocation_Loc { return d.locations }
+
+// IsRepeatable reports whether the directive may be applied more than once per location.
+func (d *Directive) IsRepeatable() bool { return d.repeatable }
+
+// Node returns the definition of the directive.
+func (d *Directive) Node() *ast.TypeSpec { return d.node }
+
+// An AppliedDirective is a directive applied to a part of the schema.
+type AppliedDirective struct {
+	name       string
+	definition *Directive
+	args       []*Argument
+	node       *ast.DirectiveLit
+}
+
+// Name returns the name of the directive, without the leading @.
+func (d *AppliedDirective) Name() string { return d.name }
+
+// Definition returns the definition of the directive. The definition
+// of a built-in directive is declared by the prelude, unless a document
+// redefines it.
+//
+func (d *AppliedDirective) Definition() *Directive { return d.definition }
+
+// Args returns the arguments of the directive, in source order.
+func (d *AppliedDirective) Args() []*Argument { return d.args }
+
+// Arg returns the named argument of the directive, or nil.
+func (d *AppliedDirective) Arg(name string) *Argument {
+	for _, a := range d.args {
+		if a.name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// Node returns the applied directive.
+func (d *AppliedDirective) Node() *ast.DirectiveLit { return d.node }
+
+// An Argument is an argument given to an applied directive.
+type Argument struct {
+	name  string
+	value ast.Node
+	node  *ast.Arg
+}
+
+// Name returns the name of the argument.
+func (a *Argument) Name() string { return a.name }
+
+// Value returns the literal value of the argument i.e. an *ast.BasicLit,
+// *ast.CompositeLit or *ast.Variable.
+//
+func (a *Argument) Value() ast.Node { return a.value }
+
+// Node returns the argument.
+func (a *Argument) Node() *ast.Arg { return a.node }
+
+func lookupInputValue(list []*InputValue, name string) *InputValue {
+	for _, v := range list {
+		if v.name == name {
+			return v
+		}
+	}
+	return nil
+}
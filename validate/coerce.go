@@ -0,0 +1,244 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/prelude"
+	"github.com/gqlc/graphql/token"
+)
+
+// A CoercionError describes why a literal value can't be coerced to a type.
+type CoercionError struct {
+	Pos token.Pos // position of the part of the value that can't be coerced
+	Msg string
+}
+
+func (e *CoercionError) Error() string { return e.Msg }
+
+func coercionErrorf(pos token.Pos, format string, args ...interface{}) error {
+	return &CoercionError{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// CoerceLiteral checks that the literal value can be coerced to the type typ,
+// following the input coercion rules of the spec. Named types are resolved
+// against the documents of c; values of custom scalars are never rejected.
+//
+// The type may be given as a node i.e. an *ast.Ident, *ast.List or
+// *ast.NonNull, or as the type field of an *ast.Field, *ast.InputValue or
+// *ast.VariableDefinition.
+// Likewise, the value may be given as a node i.e. an *ast.BasicLit,
+// *ast.CompositeLit or *ast.Variable, or as the value field of an
+// *ast.Arg or the default of an *ast.InputValue or *ast.VariableDefinition.
+//
+// If value can't be coerced, or isn't one of the above e.g. it's nil,
+// the error is a *CoercionError.
+//
+func (c *Context) CoerceLiteral(typ, value interface{}) error {
+	val := literal(value)
+	if val == nil {
+		return coercionErrorf(token.NoPos, "unsupported value of type %T", value)
+	}
+
+	if n, ok := typ.(ast.Node); ok {
+		return c.coerceLiteral(n, val)
+	}
+	return c.coerceLiteral(typeNode(typ), val)
+}
+
+func (c *Context) coerceLiteral(typ, val ast.Node) error {
+	val = literal(val)
+	if v, ok := val.(*ast.Variable); ok {
+		return coercionErrorf(v.Pos(), "cannot use variable $%s in a constant value", v.Name.Name)
+	}
+
+	if t, ok := typ.(*ast.NonNull); ok {
+		if isNull(val) {
+			return coercionErrorf(val.Pos(), "cannot use null as %s", typeString(t))
+		}
+		return c.coerceLiteral(typeNode(t.Type), val)
+	}
+	if isNull(val) {
+		return nil
+	}
+
+	switch t := typ.(type) {
+	case *ast.List:
+		elemType := typeNode(t.Type)
+		list, ok := listValues(val)
+		if !ok {
+			// a single value is coerced to a list of size one
+			return c.coerceLiteral(elemType, val)
+		}
+
+		for _, v := range list {
+			if err := c.coerceLiteral(elemType, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *ast.Ident:
+		return c.coerceNamed(t.Name, val)
+	}
+	return nil
+}
+
+// coerceNamed checks that the literal val can be coerced to the named type.
+// Values of custom scalars and of unknown types are never rejected.
+//
+func (c *Context) coerceNamed(name string, val ast.Node) error {
+	lit, _ := val.(*ast.BasicLit)
+	if prelude.IsBuiltinScalar(name) {
+		ok := false
+		switch {
+		case lit == nil:
+		case name == "Int":
+			_, err := strconv.ParseInt(lit.Value, 10, 32)
+			ok = lit.Kind == token.INT && err == nil
+		case name == "Float":
+			ok = lit.Kind == token.INT || lit.Kind == token.FLOAT
+		case name == "String":
+			ok = lit.Kind == token.STRING
+		case name == "Boolean":
+			ok = lit.Kind == token.BOOL
+		case name == "ID":
+			ok = lit.Kind == token.STRING || lit.Kind == token.INT
+		}
+		if !ok {
+			return coercionErrorf(val.Pos(), "cannot use %s as %s", valueString(val), name)
+		}
+		return nil
+	}
+
+	switch c.Types[name].GetType().(type) {
+	case *ast.TypeSpec_Enum:
+		if lit != nil && lit.Kind == token.IDENT {
+			for _, ts := range c.Specs(name) {
+				for _, v := range ts.GetEnum().GetValues().GetList() {
+					if v.Name.Name == lit.Value {
+						return nil
+					}
+				}
+			}
+		}
+		return coercionErrorf(val.Pos(), "cannot use %s as %s", valueString(val), name)
+	case *ast.TypeSpec_Input:
+		obj, ok := objectValue(val)
+		if !ok {
+			return coercionErrorf(val.Pos(), "cannot use %s as %s", valueString(val), name)
+		}
+
+		pairs := make(map[string]*ast.ObjLit_Pair)
+		for _, p := range obj.Fields {
+			pairs[p.Key.Name] = p
+		}
+
+		fields := make(map[string]bool)
+		for _, ts := range c.Specs(name) {
+			for _, f := range ts.GetInput().GetFields().GetList() {
+				fields[f.Name.Name] = true
+
+				p, ok := pairs[f.Name.Name]
+				if !ok {
+					_, required := f.Type.(*ast.InputValue_NonNull)
+					if required && f.Default == nil {
+						return coercionErrorf(val.Pos(), "missing required field %s.%s", name, f.Name.Name)
+					}
+					continue
+				}
+
+				if err := c.coerceLiteral(typeNode(f.Type), p.Val); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, p := range obj.Fields {
+			if !fields[p.Key.Name] {
+				return coercionErrorf(p.Key.Pos(), "unknown field %s.%s", name, p.Key.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// literal returns the *ast.BasicLit, *ast.Variable or *ast.CompositeLit
+// holding a list or object, represented by the given value.
+//
+func literal(val interface{}) ast.Node {
+	switch v := val.(type) {
+	case *ast.Arg_BasicLit:
+		return v.BasicLit
+	case *ast.Arg_CompositeLit:
+		return literal(v.CompositeLit)
+	case *ast.Arg_Variable:
+		return v.Variable
+	case *ast.InputValue_BasicLit:
+		return v.BasicLit
+	case *ast.InputValue_CompositeLit:
+		return literal(v.CompositeLit)
+	case *ast.VariableDefinition_BasicLit:
+		return v.BasicLit
+	case *ast.VariableDefinition_CompositeLit:
+		return literal(v.CompositeLit)
+	case *ast.CompositeLit:
+		switch cv := v.Value.(type) {
+		case *ast.CompositeLit_BasicLit:
+			return cv.BasicLit
+		case *ast.CompositeLit_Variable:
+			return cv.Variable
+		}
+		return v
+	case ast.Node:
+		return v
+	}
+	return nil
+}
+
+func isNull(val ast.Node) bool {
+	lit, ok := val.(*ast.BasicLit)
+	return ok && lit.Kind == token.NULL
+}
+
+// listValues returns the values of a list literal.
+func listValues(val ast.Node) (vals []ast.Node, ok bool) {
+	cl, _ := val.(*ast.CompositeLit)
+	l, ok := cl.GetValue().(*ast.CompositeLit_ListLit)
+	if !ok {
+		return nil, false
+	}
+
+	switch v := l.ListLit.List.(type) {
+	case *ast.ListLit_BasicList:
+		for _, x := range v.BasicList.Values {
+			vals = append(vals, x)
+		}
+	case *ast.ListLit_CompositeList:
+		for _, x := range v.CompositeList.Values {
+			vals = append(vals, x)
+		}
+	}
+	return vals, true
+}
+
+// objectValue returns the object literal held by val.
+func objectValue(val ast.Node) (*ast.ObjLit, bool) {
+	cl, _ := val.(*ast.CompositeLit)
+	o, ok := cl.GetValue().(*ast.CompositeLit_ObjLit)
+	if !ok {
+		return nil, false
+	}
+	return o.ObjLit, true
+}
+
+// valueString returns a short description of a literal for error messages.
+func valueString(val ast.Node) string {
+	if lit, ok := val.(*ast.BasicLit); ok {
+		return lit.Value
+	}
+	if _, ok := listValues(val); ok {
+		return "list"
+	}
+	return "object"
+}
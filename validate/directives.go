@@ -0,0 +1,165 @@
+package validate
+
+import (
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/token"
+)
+
+// KnownDirectives checks every applied directive against its definition.
+// The directive must be defined or built-in, it must be applied in one of
+// its locations and a directive that isn't repeatable may only be applied
+// once per location. Its arguments must be defined, required arguments
+// must be provided and every argument value must be coercible to the type
+// of the argument.
+//
+var KnownDirectives = Rule{Name: "KnownDirectives", Check: checkKnownDirectives}
+
+func checkKnownDirectives(c *Context) {
+	for _, doc := range c.Docs {
+		c.directives(ast.DirectiveLocation_DOCUMENT, doc.Directives)
+	}
+
+	var schemas []*ast.DirectiveLit
+	if len(c.Schemas) > 0 {
+		schemas = append(schemas, c.Schemas[0].GetTypeSpec().Directives...)
+	}
+	for _, td := range c.SchemaExtensions {
+		schemas = append(schemas, td.GetTypeExtSpec().Type.Directives...)
+	}
+	c.directives(ast.DirectiveLocation_SCHEMA, schemas)
+	for i := 1; i < len(c.Schemas); i++ {
+		c.directives(ast.DirectiveLocation_SCHEMA, c.Schemas[i].GetTypeSpec().Directives)
+	}
+
+	for _, name := range c.typeNames() {
+		var dirs []*ast.DirectiveLit
+		for _, ts := range c.Specs(name) {
+			dirs = append(dirs, ts.Directives...)
+		}
+		if def, ok := c.Types[name]; ok {
+			c.directives(location(def), dirs)
+		} else if exts := c.Extensions[name]; len(exts) > 0 {
+			c.directives(location(exts[0]), dirs)
+		}
+
+		for _, ts := range c.Specs(name) {
+			switch v := ts.Type.(type) {
+			case *ast.TypeSpec_Object, *ast.TypeSpec_Interface:
+				for _, f := range fieldList(ts) {
+					c.directives(ast.DirectiveLocation_FIELD_DEFINITION, f.Directives)
+					for _, a := range f.Args.GetList() {
+						c.directives(ast.DirectiveLocation_ARGUMENT_DEFINITION, a.Directives)
+					}
+				}
+			case *ast.TypeSpec_Enum:
+				for _, f := range v.Enum.Values.GetList() {
+					c.directives(ast.DirectiveLocation_ENUM_VALUE, f.Directives)
+				}
+			case *ast.TypeSpec_Input:
+				for _, f := range v.Input.Fields.GetList() {
+					c.directives(ast.DirectiveLocation_INPUT_FIELD_DEFINITION, f.Directives)
+				}
+			}
+		}
+	}
+
+	for _, ts := range c.definitions() {
+		if d, ok := ts.Type.(*ast.TypeSpec_Directive); ok {
+			for _, a := range d.Directive.Args.GetList() {
+				c.directives(ast.DirectiveLocation_ARGUMENT_DEFINITION, a.Directives)
+			}
+		}
+	}
+}
+
+// directives checks the directives applied at a single location.
+func (c *Context) directives(loc ast.DirectiveLocation_Loc, dirs []*ast.DirectiveLit) {
+	applied := make(map[string]*ast.DirectiveLit)
+	for _, d := range dirs {
+		pos := token.Pos(d.AtPos)
+
+		ts, ok := c.Directives[d.Name]
+		if !ok {
+			c.Errorf(pos, "unknown directive: @%s", d.Name)
+			continue
+		}
+		def := ts.GetDirective()
+
+		if !hasLocation(def, loc) {
+			c.Errorf(pos, "directive @%s may not be used on %s", d.Name, loc)
+		}
+
+		if first, ok := applied[d.Name]; ok && !def.Repeatable {
+			c.RelatedErrorf(pos, []token.Pos{token.Pos(first.AtPos)}, "directive @%s may only be used once at this location", d.Name)
+		} else if !ok {
+			applied[d.Name] = d
+		}
+
+		c.directiveArgs(d, def)
+	}
+}
+
+// directiveArgs checks the arguments of the applied directive d against its definition.
+func (c *Context) directiveArgs(d *ast.DirectiveLit, def *ast.DirectiveType) {
+	params := make(map[string]*ast.InputValue)
+	for _, p := range def.Args.GetList() {
+		params[p.Name.Name] = p
+	}
+
+	args := make(map[string]bool)
+	for _, a := range d.Args.GetArgs() {
+		if args[a.Name.Name] {
+			c.Errorf(a.Name.Pos(), "duplicate argument: @%s(%s:)", d.Name, a.Name.Name)
+			continue
+		}
+		args[a.Name.Name] = true
+
+		p, ok := params[a.Name.Name]
+		if !ok {
+			c.Errorf(a.Name.Pos(), "unknown argument: @%s(%s:)", d.Name, a.Name.Name)
+			continue
+		}
+
+		if err := c.CoerceLiteral(p.Type, a.Value); err != nil {
+			e := err.(*CoercionError)
+			c.Errorf(e.Pos, "argument @%s(%s:): %s", d.Name, a.Name.Name, e.Msg)
+		}
+	}
+
+	for _, p := range def.Args.GetList() {
+		_, required := p.Type.(*ast.InputValue_NonNull)
+		if required && p.Default == nil && !args[p.Name.Name] {
+			c.Errorf(token.Pos(d.AtPos), "missing required argument: @%s(%s:)", d.Name, p.Name.Name)
+		}
+	}
+}
+
+func hasLocation(def *ast.DirectiveType, loc ast.DirectiveLocation_Loc) bool {
+	for _, l := range def.Locs {
+		if l.Loc == loc {
+			return true
+		}
+	}
+	return false
+}
+
+// location returns the directive location of a type spec.
+func location(ts *ast.TypeSpec) ast.DirectiveLocation_Loc {
+	switch ts.Type.(type) {
+	case *ast.TypeSpec_Schema:
+		return ast.DirectiveLocation_SCHEMA
+	case *ast.TypeSpec_Scalar:
+		return ast.DirectiveLocation_SCALAR
+	case *ast.TypeSpec_Object:
+		return ast.DirectiveLocation_OBJECT
+	case *ast.TypeSpec_Interface:
+		return ast.DirectiveLocation_INTERFACE
+	case *ast.TypeSpec_Union:
+		return ast.DirectiveLocation_UNION
+	case *ast.TypeSpec_Enum:
+		return ast.DirectiveLocation_ENUM
+	case *ast.TypeSpec_Input:
+		return ast.DirectiveLocation_INPUT_OBJECT
+	}
+	return ast.DirectiveLocation_NoPos
+}
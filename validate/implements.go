@@ -0,0 +1,227 @@
+package validate
+
+import (
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/token"
+)
+
+// InterfaceImplementations checks that objects and interfaces correctly
+// implement the interfaces they declare. Every interface field must be
+// provided by the implementer with the same arguments and a return type
+// that is a subtype of the interface field's type, any additional arguments
+// must be optional, and the interfaces implemented by an interface must be
+// declared by the implementer as well.
+//
+// Violations concerning an interface field are related to its position.
+//
+var InterfaceImplementations = Rule{Name: "InterfaceImplementations", Check: checkInterfaceImplementations}
+
+func checkInterfaceImplementations(c *Context) {
+	for _, name := range c.typeNames() {
+		switch c.Types[name].GetType().(type) {
+		case *ast.TypeSpec_Object, *ast.TypeSpec_Interface:
+		default:
+			continue
+		}
+
+		var ifaces []*ast.Ident
+		declared := make(map[string]bool)
+		for _, id := range c.Interfaces(name) {
+			switch {
+			case declared[id.Name]:
+				c.Errorf(id.Pos(), "%s %s: duplicate interface: %s", kind(c.Types[name]), name, id.Name)
+				continue
+			case id.Name == name:
+				c.Errorf(id.Pos(), "%s %s cannot implement itself", kind(c.Types[name]), name)
+				continue
+			}
+			declared[id.Name] = true
+			ifaces = append(ifaces, id)
+
+			def, ok := c.Types[id.Name]
+			if !ok {
+				continue
+			}
+			if _, ok := def.Type.(*ast.TypeSpec_Interface); !ok {
+				c.Errorf(id.Pos(), "%s %s cannot implement %s %s", kind(c.Types[name]), name, kind(def), id.Name)
+				continue
+			}
+
+			c.implements(name, id)
+		}
+
+		for _, id := range ifaces {
+			for _, t := range c.Interfaces(id.Name) {
+				if !declared[t.Name] && t.Name != name {
+					c.RelatedErrorf(id.Pos(), []token.Pos{t.Pos()}, "%s %s must implement %s, because it is implemented by %s", kind(c.Types[name]), name, t.Name, id.Name)
+				}
+			}
+		}
+	}
+}
+
+// implements checks that the fields of the named type
+// implement the fields of the interface iface.
+//
+func (c *Context) implements(name string, iface *ast.Ident) {
+	fields := make(map[string]*ast.Field)
+	for _, f := range c.Fields(name) {
+		if _, exists := fields[f.Name.Name]; !exists {
+			fields[f.Name.Name] = f
+		}
+	}
+
+	for _, want := range c.Fields(iface.Name) {
+		wantName := iface.Name + "." + want.Name.Name
+		related := []token.Pos{want.Name.Pos()}
+
+		got, ok := fields[want.Name.Name]
+		if !ok {
+			c.RelatedErrorf(iface.Pos(), related, "%s %s does not implement %s: missing field %s", kind(c.Types[name]), name, iface.Name, wantName)
+			continue
+		}
+		gotName := name + "." + got.Name.Name
+
+		if !c.isSubType(typeNode(got.Type), typeNode(want.Type)) {
+			c.RelatedErrorf(namedType(got.Type).Pos(), related, "field %s: type %s is not a subtype of %s, the type of %s", gotName, typeString(typeNode(got.Type)), typeString(typeNode(want.Type)), wantName)
+		}
+
+		args := make(map[string]*ast.InputValue)
+		for _, a := range got.Args.GetList() {
+			if _, exists := args[a.Name.Name]; !exists {
+				args[a.Name.Name] = a
+			}
+		}
+
+		declared := make(map[string]bool)
+		for _, wantArg := range want.Args.GetList() {
+			declared[wantArg.Name.Name] = true
+			related := []token.Pos{wantArg.Name.Pos()}
+
+			gotArg, ok := args[wantArg.Name.Name]
+			if !ok {
+				c.RelatedErrorf(got.Name.Pos(), related, "field %s: missing argument %s(%s:)", gotName, wantName, wantArg.Name.Name)
+				continue
+			}
+
+			gotType, wantType := typeString(typeNode(gotArg.Type)), typeString(typeNode(wantArg.Type))
+			if gotType != wantType {
+				c.RelatedErrorf(namedType(gotArg.Type).Pos(), related, "argument %s(%s:): type %s does not match %s, the type of %s(%s:)", gotName, gotArg.Name.Name, gotType, wantType, wantName, wantArg.Name.Name)
+			}
+		}
+
+		for _, a := range got.Args.GetList() {
+			if declared[a.Name.Name] {
+				continue
+			}
+
+			_, required := a.Type.(*ast.InputValue_NonNull)
+			if required && a.Default == nil {
+				c.RelatedErrorf(a.Name.Pos(), related, "argument %s(%s:): must be optional, because it is not an argument of %s", gotName, a.Name.Name, wantName)
+			}
+		}
+	}
+}
+
+// isSubType reports whether the implementing field type got is a valid
+// subtype of the interface field type want i.e. it is the same type, or
+// is covariant to it by being non-null, an implementation of it or a
+// member of it.
+//
+func (c *Context) isSubType(got, want ast.Node) bool {
+	if g, ok := got.(*ast.NonNull); ok {
+		if w, ok := want.(*ast.NonNull); ok {
+			want = typeNode(w.Type)
+		}
+		return c.isSubType(typeNode(g.Type), want)
+	}
+
+	switch w := want.(type) {
+	case *ast.NonNull:
+		return false
+	case *ast.List:
+		g, ok := got.(*ast.List)
+		return ok && c.isSubType(typeNode(g.Type), typeNode(w.Type))
+	case *ast.Ident:
+		g, ok := got.(*ast.Ident)
+		if !ok {
+			return false
+		}
+		if g.Name == w.Name {
+			return true
+		}
+
+		switch c.Types[w.Name].GetType().(type) {
+		case *ast.TypeSpec_Interface:
+			for _, id := range c.Interfaces(g.Name) {
+				if id.Name == w.Name {
+					return true
+				}
+			}
+		case *ast.TypeSpec_Union:
+			if _, ok := c.Types[g.Name].GetType().(*ast.TypeSpec_Object); !ok {
+				return false
+			}
+			for _, ts := range c.Specs(w.Name) {
+				if u, ok := ts.Type.(*ast.TypeSpec_Union); ok {
+					for _, id := range u.Union.Members {
+						if id.Name == g.Name {
+							return true
+						}
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// typeNode returns the *ast.Ident, *ast.List or *ast.NonNull
+// wrapped by the given type reference.
+//
+func typeNode(typ interface{}) ast.Node {
+	switch v := typ.(type) {
+	case *ast.Field_Ident:
+		return v.Ident
+	case *ast.Field_List:
+		return v.List
+	case *ast.Field_NonNull:
+		return v.NonNull
+	case *ast.InputValue_Ident:
+		return v.Ident
+	case *ast.InputValue_List:
+		return v.List
+	case *ast.InputValue_NonNull:
+		return v.NonNull
+	case *ast.VariableDefinition_Ident:
+		return v.Ident
+	case *ast.VariableDefinition_List:
+		return v.List
+	case *ast.VariableDefinition_NonNull:
+		return v.NonNull
+	case *ast.List_Ident:
+		return v.Ident
+	case *ast.List_List:
+		return v.List
+	case *ast.List_NonNull:
+		return v.NonNull
+	case *ast.NonNull_Ident:
+		return v.Ident
+	case *ast.NonNull_List:
+		return v.List
+	}
+	return nil
+}
+
+// typeString returns the type as it would be written in a document e.g. [Int!]!.
+func typeString(n ast.Node) string {
+	switch v := n.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.List:
+		return "[" + typeString(typeNode(v.Type)) + "]"
+	case *ast.NonNull:
+		return typeString(typeNode(v.Type)) + "!"
+	}
+	return ""
+}
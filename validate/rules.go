@@ -0,0 +1,598 @@
+package validate
+
+import (
+	"strings"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/token"
+)
+
+// UniqueTypeNames checks that every type and directive is defined
+// at most once, and that there is at most one schema definition.
+var UniqueTypeNames = Rule{Name: "UniqueTypeNames", Check: checkUniqueTypeNames}
+
+func checkUniqueTypeNames(c *Context) {
+	types := make(map[string]bool)
+	directives := make(map[string]bool)
+	for _, ts := range c.definitions() {
+		switch ts.Type.(type) {
+		case *ast.TypeSpec_Schema:
+		case *ast.TypeSpec_Directive:
+			if directives[ts.Name.Name] {
+				c.Errorf(ts.Name.Pos(), "duplicate directive: @%s", ts.Name.Name)
+			}
+			directives[ts.Name.Name] = true
+		default:
+			if types[ts.Name.Name] {
+				c.Errorf(ts.Name.Pos(), "duplicate type: %s", ts.Name.Name)
+			}
+			types[ts.Name.Name] = true
+		}
+	}
+
+	for i := 1; i < len(c.Schemas); i++ {
+		c.Errorf(token.Pos(c.Schemas[i].TokPos), "duplicate schema definition")
+	}
+}
+
+// ReservedNames checks that no type, field, argument, enum value or
+// directive name begins with "__", which is reserved for introspection,
+// and that no enum value is named true, false or null.
+//
+var ReservedNames = Rule{Name: "ReservedNames", Check: checkReservedNames}
+
+func checkReservedNames(c *Context) {
+	reserved := func(id *ast.Ident) {
+		if strings.HasPrefix(id.Name, "__") {
+			c.Errorf(id.Pos(), "reserved name: %s must not begin with \"__\"", id.Name)
+		}
+	}
+	args := func(l *ast.InputValueList) {
+		for _, a := range l.GetList() {
+			reserved(a.Name)
+		}
+	}
+
+	for _, ts := range c.specs() {
+		if _, ok := ts.Type.(*ast.TypeSpec_Schema); ok {
+			continue
+		}
+		reserved(ts.Name)
+
+		switch v := ts.Type.(type) {
+		case *ast.TypeSpec_Object, *ast.TypeSpec_Interface:
+			for _, f := range fieldList(ts) {
+				reserved(f.Name)
+				args(f.Args)
+			}
+		case *ast.TypeSpec_Enum:
+			for _, f := range v.Enum.Values.GetList() {
+				reserved(f.Name)
+				switch f.Name.Name {
+				case "true", "false", "null":
+					c.Errorf(f.Name.Pos(), "reserved name: enum value must not be %s", f.Name.Name)
+				}
+			}
+		case *ast.TypeSpec_Input:
+			args(v.Input.Fields)
+		case *ast.TypeSpec_Directive:
+			args(v.Directive.Args)
+		}
+	}
+}
+
+// KnownTypes checks that every referenced type is defined, and that
+// every type extension extends a defined type of the same kind.
+//
+var KnownTypes = Rule{Name: "KnownTypes", Check: checkKnownTypes}
+
+func checkKnownTypes(c *Context) {
+	known := func(id *ast.Ident) {
+		if id != nil && !c.isDefined(id.Name) {
+			c.Errorf(id.Pos(), "unknown type: %s", id.Name)
+		}
+	}
+	args := func(l *ast.InputValueList) {
+		for _, a := range l.GetList() {
+			known(namedType(a.Type))
+		}
+	}
+
+	for _, ts := range c.specs() {
+		switch v := ts.Type.(type) {
+		case *ast.TypeSpec_Schema:
+			for _, f := range v.Schema.RootOps.GetList() {
+				known(namedType(f.Type))
+			}
+		case *ast.TypeSpec_Object:
+			for _, id := range v.Object.Interfaces {
+				known(id)
+			}
+		case *ast.TypeSpec_Interface:
+			for _, id := range v.Interface.Interfaces {
+				known(id)
+			}
+		case *ast.TypeSpec_Union:
+			for _, id := range v.Union.Members {
+				known(id)
+			}
+		case *ast.TypeSpec_Input:
+			args(v.Input.Fields)
+		case *ast.TypeSpec_Directive:
+			args(v.Directive.Args)
+		}
+
+		for _, f := range fieldList(ts) {
+			args(f.Args)
+			known(namedType(f.Type))
+		}
+	}
+
+	for _, name := range c.extended() {
+		def, ok := c.Types[name]
+		for _, ext := range c.Extensions[name] {
+			if !ok {
+				c.Errorf(ext.Name.Pos(), "cannot extend unknown type: %s", name)
+				continue
+			}
+			if kind(ext) != kind(def) {
+				c.Errorf(ext.Name.Pos(), "cannot extend %s %s as %s", kind(def), name, kind(ext))
+			}
+		}
+	}
+}
+
+// UniqueFieldNames checks that the fields, input fields and enum values
+// of a type, including those declared by its extensions, and the
+// arguments of a field or directive have unique names.
+//
+var UniqueFieldNames = Rule{Name: "UniqueFieldNames", Check: checkUniqueFieldNames}
+
+func checkUniqueFieldNames(c *Context) {
+	args := func(owner string, l *ast.InputValueList) {
+		seen := make(map[string]bool)
+		for _, a := range l.GetList() {
+			if seen[a.Name.Name] {
+				c.Errorf(a.Name.Pos(), "duplicate argument: %s(%s:)", owner, a.Name.Name)
+			}
+			seen[a.Name.Name] = true
+		}
+	}
+
+	for _, name := range c.typeNames() {
+		fields := make(map[string]bool)
+		field := func(what string, id *ast.Ident) {
+			if fields[id.Name] {
+				c.Errorf(id.Pos(), "duplicate %s: %s.%s", what, name, id.Name)
+			}
+			fields[id.Name] = true
+		}
+
+		for _, ts := range c.Specs(name) {
+			switch v := ts.Type.(type) {
+			case *ast.TypeSpec_Object, *ast.TypeSpec_Interface:
+				for _, f := range fieldList(ts) {
+					field("field", f.Name)
+					args(name+"."+f.Name.Name, f.Args)
+				}
+			case *ast.TypeSpec_Enum:
+				for _, f := range v.Enum.Values.GetList() {
+					field("enum value", f.Name)
+				}
+			case *ast.TypeSpec_Input:
+				for _, f := range v.Input.Fields.GetList() {
+					field("input field", f.Name)
+				}
+			}
+		}
+	}
+
+	for _, ts := range c.definitions() {
+		if d, ok := ts.Type.(*ast.TypeSpec_Directive); ok {
+			args("@"+ts.Name.Name, d.Directive.Args)
+		}
+	}
+}
+
+// InputOutputTypes checks that fields have output types, and that
+// arguments and input fields have input types.
+//
+var InputOutputTypes = Rule{Name: "InputOutputTypes", Check: checkInputOutputTypes}
+
+func checkInputOutputTypes(c *Context) {
+	input := func(what, owner string, l *ast.InputValueList) {
+		for _, a := range l.GetList() {
+			id := namedType(a.Type)
+			if id != nil && c.isDefined(id.Name) && !c.IsInputType(id.Name) {
+				c.Errorf(id.Pos(), what+": %s is not an input type", owner, a.Name.Name, id.Name)
+			}
+		}
+	}
+
+	for _, ts := range c.specs() {
+		switch v := ts.Type.(type) {
+		case *ast.TypeSpec_Object, *ast.TypeSpec_Interface:
+			for _, f := range fieldList(ts) {
+				owner := ts.Name.Name + "." + f.Name.Name
+				input("argument %s(%s:)", owner, f.Args)
+
+				id := namedType(f.Type)
+				if id != nil && c.isDefined(id.Name) && !c.IsOutputType(id.Name) {
+					c.Errorf(id.Pos(), "field %s: %s is not an output type", owner, id.Name)
+				}
+			}
+		case *ast.TypeSpec_Input:
+			input("input field %s.%s", ts.Name.Name, v.Input.Fields)
+		case *ast.TypeSpec_Directive:
+			input("argument %s(%s:)", "@"+ts.Name.Name, v.Directive.Args)
+		}
+	}
+}
+
+// UnionMembers checks that the members of a union are unique object types.
+var UnionMembers = Rule{Name: "UnionMembers", Check: checkUnionMembers}
+
+func checkUnionMembers(c *Context) {
+	for _, name := range c.typeNames() {
+		seen := make(map[string]bool)
+		for _, ts := range c.Specs(name) {
+			u, ok := ts.Type.(*ast.TypeSpec_Union)
+			if !ok {
+				continue
+			}
+
+			for _, id := range u.Union.Members {
+				if seen[id.Name] {
+					c.Errorf(id.Pos(), "duplicate union member: %s.%s", name, id.Name)
+				}
+				seen[id.Name] = true
+
+				if _, ok := c.Types[id.Name].GetType().(*ast.TypeSpec_Object); !ok && c.isDefined(id.Name) {
+					c.Errorf(id.Pos(), "union member %s.%s: %s is not an object type", name, id.Name, id.Name)
+				}
+			}
+		}
+	}
+}
+
+// NonEmptyTypes checks that objects, interfaces and input objects have
+// at least one field, that enums have at least one value and that unions
+// have at least one member. Fields, values and members declared by
+// extensions are counted as well.
+//
+var NonEmptyTypes = Rule{Name: "NonEmptyTypes", Check: checkNonEmptyTypes}
+
+func checkNonEmptyTypes(c *Context) {
+	for _, name := range c.typeNames() {
+		def, ok := c.Types[name]
+		if !ok || kind(def) == "scalar" {
+			continue
+		}
+
+		var n int
+		for _, ts := range c.Specs(name) {
+			if kind(ts) != kind(def) {
+				continue
+			}
+
+			switch v := ts.Type.(type) {
+			case *ast.TypeSpec_Object, *ast.TypeSpec_Interface:
+				n += len(fieldList(ts))
+			case *ast.TypeSpec_Union:
+				n += len(v.Union.Members)
+			case *ast.TypeSpec_Enum:
+				n += len(v.Enum.Values.GetList())
+			case *ast.TypeSpec_Input:
+				n += len(v.Input.Fields.GetList())
+			}
+		}
+		if n > 0 {
+			continue
+		}
+
+		switch def.Type.(type) {
+		case *ast.TypeSpec_Union:
+			c.Errorf(def.Name.Pos(), "union %s must have one or more member types", name)
+		case *ast.TypeSpec_Enum:
+			c.Errorf(def.Name.Pos(), "enum %s must define one or more values", name)
+		default:
+			c.Errorf(def.Name.Pos(), "%s %s must define one or more fields", kind(def), name)
+		}
+	}
+}
+
+// DefaultValues checks that the default values of arguments and input
+// fields can be coerced to their types.
+//
+var DefaultValues = Rule{Name: "DefaultValues", Check: checkDefaultValues}
+
+func checkDefaultValues(c *Context) {
+	defaults := func(what, owner string, l *ast.InputValueList) {
+		for _, a := range l.GetList() {
+			if a.Default == nil {
+				continue
+			}
+
+			if err := c.CoerceLiteral(a.Type, a.Default); err != nil {
+				e := err.(*CoercionError)
+				c.Errorf(e.Pos, "default value of "+what+": %s", owner, a.Name.Name, e.Msg)
+			}
+		}
+	}
+
+	for _, ts := range c.specs() {
+		switch v := ts.Type.(type) {
+		case *ast.TypeSpec_Object, *ast.TypeSpec_Interface:
+			for _, f := range fieldList(ts) {
+				defaults("argument %s(%s:)", ts.Name.Name+"."+f.Name.Name, f.Args)
+			}
+		case *ast.TypeSpec_Input:
+			defaults("input field %s.%s", ts.Name.Name, v.Input.Fields)
+		case *ast.TypeSpec_Directive:
+			defaults("argument %s(%s:)", "@"+ts.Name.Name, v.Directive.Args)
+		}
+	}
+}
+
+// InputObjectCycles checks that no input object references itself through
+// a chain of non-null, non-list fields, since such an input object could
+// never be constructed. Every cycle is reported at its first field, with
+// the fields of the remaining hops as related positions.
+//
+var InputObjectCycles = Rule{Name: "InputObjectCycles", Check: checkInputObjectCycles}
+
+func checkInputObjectCycles(c *Context) {
+	// Every cycle is enumerated once, from the input object in it which is
+	// declared first: the search from root only visits objects declared after it.
+	names := c.typeNames()
+	order := make(map[string]int)
+	for _, name := range names {
+		if _, ok := c.Types[name].GetType().(*ast.TypeSpec_Input); ok {
+			order[name] = len(order)
+		}
+	}
+
+	var root string
+	onPath := make(map[string]bool)
+	var path []*ast.InputValue
+	var pathTypes []string
+
+	var detect func(name string)
+	detect = func(name string) {
+		onPath[name] = true
+		pathTypes = append(pathTypes, name)
+
+		for _, ts := range c.Specs(name) {
+			for _, f := range ts.GetInput().GetFields().GetList() {
+				nn, ok := f.Type.(*ast.InputValue_NonNull)
+				if !ok {
+					continue
+				}
+				id := nn.NonNull.GetIdent()
+				if id == nil {
+					continue
+				}
+				i, ok := order[id.Name]
+				if !ok {
+					continue
+				}
+
+				path = append(path, f)
+				switch {
+				case id.Name == root:
+					c.cycle(pathTypes, path)
+				case i > order[root] && !onPath[id.Name]:
+					detect(id.Name)
+				}
+				path = path[:len(path)-1]
+			}
+		}
+
+		pathTypes = pathTypes[:len(pathTypes)-1]
+		delete(onPath, name)
+	}
+
+	for _, name := range names {
+		if _, ok := order[name]; ok {
+			root = name
+			detect(name)
+		}
+	}
+}
+
+// cycle reports a cycle of input object fields, where fields[i] is declared by types[i].
+func (c *Context) cycle(types []string, fields []*ast.InputValue) {
+	var hops []string
+	var related []token.Pos
+	for i, f := range fields {
+		hops = append(hops, types[i]+"."+f.Name.Name)
+		if i > 0 {
+			related = append(related, f.Name.Pos())
+		}
+	}
+	hops = append(hops, types[0])
+
+	c.RelatedErrorf(fields[0].Name.Pos(), related, "input object %s references itself through non-null fields: %s", types[0], strings.Join(hops, " -> "))
+}
+
+// RootOperationTypes checks the root operation types of the schema.
+// If the schema is defined, it must define the query root operation,
+// its operations must be named query, mutation or subscription and may
+// only be defined once. Otherwise, a type named Query must exist. Root
+// operation types must be object types.
+//
+var RootOperationTypes = Rule{Name: "RootOperationTypes", Check: checkRootOperationTypes}
+
+func checkRootOperationTypes(c *Context) {
+	if len(c.Schemas) == 0 && len(c.SchemaExtensions) == 0 {
+		query, ok := c.Types["Query"]
+		if !ok {
+			c.Errorf(token.NoPos, "missing query root operation type: no type named Query")
+			return
+		}
+		c.rootType("query", query.Name)
+		return
+	}
+
+	var decls []*ast.TypeDecl
+	if len(c.Schemas) > 0 {
+		decls = append(decls, c.Schemas[0])
+	}
+	decls = append(decls, c.SchemaExtensions...)
+
+	ops := make(map[string]bool)
+	for _, td := range decls {
+		for _, f := range schemaType(td).RootOps.GetList() {
+			switch f.Name.Name {
+			case "query", "mutation", "subscription":
+			default:
+				c.Errorf(f.Name.Pos(), "unknown root operation: %s", f.Name.Name)
+				continue
+			}
+
+			if ops[f.Name.Name] {
+				c.Errorf(f.Name.Pos(), "duplicate root operation: %s", f.Name.Name)
+			}
+			ops[f.Name.Name] = true
+
+			if id := namedType(f.Type); id != nil {
+				c.rootType(f.Name.Name, id)
+			}
+		}
+	}
+
+	if !ops["query"] {
+		c.Errorf(token.Pos(decls[0].TokPos), "schema does not define the query root operation")
+	}
+}
+
+// rootType reports an error if the root operation type id is not an object.
+func (c *Context) rootType(op string, id *ast.Ident) {
+	if !c.isDefined(id.Name) {
+		return
+	}
+	if _, ok := c.Types[id.Name].GetType().(*ast.TypeSpec_Object); !ok {
+		c.Errorf(id.Pos(), "%s root operation type %s must be an object type", op, id.Name)
+	}
+}
+
+// definitions returns every type, directive and schema definition, in document order.
+func (c *Context) definitions() (specs []*ast.TypeSpec) {
+	for _, doc := range c.Docs {
+		for _, td := range doc.Types {
+			if v, ok := td.Spec.(*ast.TypeDecl_TypeSpec); ok {
+				specs = append(specs, v.TypeSpec)
+			}
+		}
+	}
+	return
+}
+
+// specs returns every definition and extension, in document order.
+func (c *Context) specs() (specs []*ast.TypeSpec) {
+	for _, doc := range c.Docs {
+		for _, td := range doc.Types {
+			switch v := td.Spec.(type) {
+			case *ast.TypeDecl_TypeSpec:
+				specs = append(specs, v.TypeSpec)
+			case *ast.TypeDecl_TypeExtSpec:
+				specs = append(specs, v.TypeExtSpec.Type)
+			}
+		}
+	}
+	return
+}
+
+// typeNames returns the names of every defined or extended type, in
+// the order in which they are first declared.
+//
+func (c *Context) typeNames() (names []string) {
+	seen := make(map[string]bool)
+	for _, ts := range c.specs() {
+		switch ts.Type.(type) {
+		case *ast.TypeSpec_Schema, *ast.TypeSpec_Directive:
+			continue
+		}
+		if !seen[ts.Name.Name] {
+			seen[ts.Name.Name] = true
+			names = append(names, ts.Name.Name)
+		}
+	}
+	return
+}
+
+// extended returns the names of every extended type, in the order
+// in which they are first extended.
+//
+func (c *Context) extended() (names []string) {
+	for _, name := range c.typeNames() {
+		if len(c.Extensions[name]) > 0 {
+			names = append(names, name)
+		}
+	}
+	return
+}
+
+// isDefined reports whether the named type is defined or built-in.
+func (c *Context) isDefined(name string) bool {
+	_, ok := c.Types[name]
+	return ok
+}
+
+// fieldList returns the fields of an object or interface type spec.
+func fieldList(ts *ast.TypeSpec) []*ast.Field {
+	switch v := ts.Type.(type) {
+	case *ast.TypeSpec_Object:
+		return v.Object.Fields.GetList()
+	case *ast.TypeSpec_Interface:
+		return v.Interface.Fields.GetList()
+	}
+	return nil
+}
+
+// namedType returns the named type at the core of the given type
+// reference i.e. it unwraps any List and NonNull types.
+//
+func namedType(typ interface{}) *ast.Ident {
+	switch v := typeNode(typ).(type) {
+	case *ast.Ident:
+		return v
+	case *ast.List:
+		return namedType(v.Type)
+	case *ast.NonNull:
+		return namedType(v.Type)
+	}
+	return nil
+}
+
+// kind returns the keyword that declares the given type spec.
+func kind(ts *ast.TypeSpec) string {
+	switch ts.Type.(type) {
+	case *ast.TypeSpec_Schema:
+		return "schema"
+	case *ast.TypeSpec_Scalar:
+		return "scalar"
+	case *ast.TypeSpec_Object:
+		return "type"
+	case *ast.TypeSpec_Interface:
+		return "interface"
+	case *ast.TypeSpec_Union:
+		return "union"
+	case *ast.TypeSpec_Enum:
+		return "enum"
+	case *ast.TypeSpec_Input:
+		return "input"
+	case *ast.TypeSpec_Directive:
+		return "directive"
+	}
+	return ""
+}
+
+// schemaType returns the schema of a schema definition or extension.
+func schemaType(td *ast.TypeDecl) *ast.SchemaType {
+	ts := td.GetTypeSpec()
+	if ts == nil {
+		ts = td.GetTypeExtSpec().GetType()
+	}
+	return ts.GetSchema()
+}
@@ -0,0 +1,345 @@
+// Package validate implements the type system validation rules
+// of the GraphQL spec for schemas represented by ast.Documents.
+package validate
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/prelude"
+	"github.com/gqlc/graphql/token"
+)
+
+// A Rule is a single validation rule.
+type Rule struct {
+	Name  string         // name of the rule; reported by its Errors
+	Check func(*Context) // reports every violation of the rule via Context.Errorf
+}
+
+// Rules are the type system validation rules applied by Validate.
+var Rules = []Rule{
+	UniqueTypeNames,
+	ReservedNames,
+	KnownTypes,
+	UniqueFieldNames,
+	InputOutputTypes,
+	UnionMembers,
+	NonEmptyTypes,
+	DefaultValues,
+	InputObjectCycles,
+	InterfaceImplementations,
+	KnownDirectives,
+	RootOperationTypes,
+}
+
+// Mode represents a validation mode.
+type Mode uint
+
+// Mode Options
+const (
+	Prelude Mode = 1 << iota // resolve the introspection types of the prelude, too
+)
+
+// A Config controls the behaviour of Validate.
+type Config struct {
+	Mode  Mode   // default: 0
+	Rules []Rule // rules to apply; default: Rules
+}
+
+// Validate checks that the given documents form a valid schema, by
+// applying the rules of cfg to them. The documents are treated as one
+// schema i.e. a type may be declared in one document and referenced
+// or extended in another.
+//
+// The built-in scalars and directives of the prelude are always known.
+// If the Prelude mode is set, so are the introspection types.
+//
+// If the schema is invalid, the error is an ErrorList, which
+// contains an Error for every violation, sorted by position.
+//
+func (cfg *Config) Validate(dset *token.DocSet, docs ...*ast.Document) error {
+	rules := cfg.Rules
+	if rules == nil {
+		rules = Rules
+	}
+	return check(cfg.NewContext(dset, docs...), rules)
+}
+
+// NewContext returns a Context indexing the declarations in the given
+// documents and the prelude. It may be used to apply the helpers of a
+// Context, such as CoerceLiteral, outside of a Rule.
+//
+func (cfg *Config) NewContext(dset *token.DocSet, docs ...*ast.Document) *Context {
+	c := &Context{
+		Docs:       docs,
+		Types:      make(map[string]*ast.TypeSpec),
+		Directives: make(map[string]*ast.TypeSpec),
+		Extensions: make(map[string][]*ast.TypeSpec),
+		dset:       dset,
+	}
+	for _, doc := range docs {
+		c.index(doc)
+	}
+
+	for _, td := range prelude.Document().Types {
+		ts := td.GetTypeSpec()
+		if ts.GetDirective() == nil && !prelude.IsBuiltinScalar(ts.Name.Name) && cfg.Mode&Prelude == 0 {
+			continue
+		}
+		c.define(ts)
+	}
+	return c
+}
+
+// Validate checks that the given documents form a valid schema, by
+// applying all of the Rules to them. It is equivalent to calling
+// Validate on a zero Config.
+//
+func Validate(dset *token.DocSet, docs ...*ast.Document) error {
+	return Check(dset, Rules, docs...)
+}
+
+// Check is like Validate, but only applies the given rules.
+func Check(dset *token.DocSet, rules []Rule, docs ...*ast.Document) error {
+	return check(NewContext(dset, docs...), rules)
+}
+
+func check(c *Context, rules []Rule) error {
+	for _, r := range rules {
+		c.rule = r.Name
+		r.Check(c)
+	}
+
+	c.errs.Sort()
+	return c.errs.Err()
+}
+
+// Context provides a Rule with an index of the declarations in
+// the documents being validated, and collects the reported errors.
+//
+type Context struct {
+	Docs []*ast.Document
+
+	// Types maps type names to their first definition. Directives
+	// maps directive names to their first definition. Both include
+	// the declarations of the prelude, unless they are redefined.
+	//
+	Types      map[string]*ast.TypeSpec
+	Directives map[string]*ast.TypeSpec
+
+	// Extensions maps type names to their extensions, in document order.
+	Extensions map[string][]*ast.TypeSpec
+
+	// Schemas holds every schema definition and SchemaExtensions
+	// every schema extension, in document order.
+	//
+	Schemas          []*ast.TypeDecl
+	SchemaExtensions []*ast.TypeDecl
+
+	dset *token.DocSet
+	rule string
+	errs ErrorList
+}
+
+// NewContext returns a Context indexing the declarations in the given
+// documents and the built-in scalars and directives of the prelude. It is
+// equivalent to calling NewContext on a zero Config.
+//
+func NewContext(dset *token.DocSet, docs ...*ast.Document) *Context {
+	return new(Config).NewContext(dset, docs...)
+}
+
+// index records the declarations of doc.
+func (c *Context) index(doc *ast.Document) {
+	for _, td := range doc.Types {
+		switch v := td.Spec.(type) {
+		case *ast.TypeDecl_TypeSpec:
+			if _, ok := v.TypeSpec.Type.(*ast.TypeSpec_Schema); ok {
+				c.Schemas = append(c.Schemas, td)
+				continue
+			}
+			c.define(v.TypeSpec)
+		case *ast.TypeDecl_TypeExtSpec:
+			ts := v.TypeExtSpec.Type
+			if _, ok := ts.Type.(*ast.TypeSpec_Schema); ok {
+				c.SchemaExtensions = append(c.SchemaExtensions, td)
+				continue
+			}
+			c.Extensions[ts.Name.Name] = append(c.Extensions[ts.Name.Name], ts)
+		}
+	}
+}
+
+// define records ts, unless a type or directive of the same name is already defined.
+func (c *Context) define(ts *ast.TypeSpec) {
+	defs := c.Types
+	if _, ok := ts.Type.(*ast.TypeSpec_Directive); ok {
+		defs = c.Directives
+	}
+	if _, exists := defs[ts.Name.Name]; !exists {
+		defs[ts.Name.Name] = ts
+	}
+}
+
+// Errorf reports a violation of the current rule at pos.
+func (c *Context) Errorf(pos token.Pos, format string, args ...interface{}) {
+	c.RelatedErrorf(pos, nil, format, args...)
+}
+
+// RelatedErrorf is like Errorf, but also records the positions of
+// nodes related to the violation e.g. the declaration that conflicts
+// with the node at pos.
+//
+func (c *Context) RelatedErrorf(pos token.Pos, related []token.Pos, format string, args ...interface{}) {
+	e := &Error{
+		Pos:      pos,
+		Position: c.position(pos),
+		Rule:     c.rule,
+		Msg:      fmt.Sprintf(format, args...),
+	}
+	for _, p := range related {
+		e.Related = append(e.Related, c.position(p))
+	}
+	c.errs = append(c.errs, e)
+}
+
+func (c *Context) position(pos token.Pos) (position token.Position) {
+	if c.dset != nil {
+		position = c.dset.Position(pos)
+	}
+	return
+}
+
+// Specs returns the definition of the named type, followed by all
+// of its extensions. The definition is omitted if it doesn't exist.
+//
+func (c *Context) Specs(name string) []*ast.TypeSpec {
+	exts := c.Extensions[name]
+	def, ok := c.Types[name]
+	if !ok {
+		return exts
+	}
+
+	specs := make([]*ast.TypeSpec, 0, len(exts)+1)
+	specs = append(specs, def)
+	return append(specs, exts...)
+}
+
+// Fields returns the fields of the named object or interface,
+// including the fields declared by its extensions.
+//
+func (c *Context) Fields(name string) (fields []*ast.Field) {
+	for _, ts := range c.Specs(name) {
+		fields = append(fields, fieldList(ts)...)
+	}
+	return
+}
+
+// Interfaces returns the interfaces implemented by the named object or
+// interface, including the interfaces added by its extensions.
+//
+func (c *Context) Interfaces(name string) (ifaces []*ast.Ident) {
+	for _, ts := range c.Specs(name) {
+		switch v := ts.Type.(type) {
+		case *ast.TypeSpec_Object:
+			ifaces = append(ifaces, v.Object.Interfaces...)
+		case *ast.TypeSpec_Interface:
+			ifaces = append(ifaces, v.Interface.Interfaces...)
+		}
+	}
+	return
+}
+
+// IsInputType reports whether the named type may be used as the type
+// of an argument or input field i.e. it is a scalar, enum or input object.
+//
+func (c *Context) IsInputType(name string) bool {
+	switch c.Types[name].GetType().(type) {
+	case *ast.TypeSpec_Scalar, *ast.TypeSpec_Enum, *ast.TypeSpec_Input:
+		return true
+	}
+	return false
+}
+
+// IsOutputType reports whether the named type may be used as the type of
+// a field i.e. it is a scalar, object, interface, union or enum.
+//
+func (c *Context) IsOutputType(name string) bool {
+	switch c.Types[name].GetType().(type) {
+	case *ast.TypeSpec_Scalar, *ast.TypeSpec_Object, *ast.TypeSpec_Interface, *ast.TypeSpec_Union, *ast.TypeSpec_Enum:
+		return true
+	}
+	return false
+}
+
+// Error represents a single violation of a validation rule.
+type Error struct {
+	Pos      token.Pos      // position of the offending node
+	Position token.Position // full position of the offending node
+	Rule     string         // name of the violated rule
+	Msg      string
+
+	// Related holds the positions of nodes related to the
+	// violation, if any e.g. the interface field that is not
+	// correctly implemented by the offending node.
+	//
+	Related []token.Position
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Position.Filename != "" || e.Position.IsValid() {
+		return e.Position.String() + ": " + e.Msg
+	}
+	return e.Msg
+}
+
+// ErrorList is a list of *Errors.
+// The zero value for an ErrorList is an empty ErrorList ready to use.
+//
+type ErrorList []*Error
+
+// ErrorList implements the sort Interface.
+func (p ErrorList) Len() int      { return len(p) }
+func (p ErrorList) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+
+func (p ErrorList) Less(i, j int) bool {
+	e := &p[i].Position
+	f := &p[j].Position
+	if e.Filename != f.Filename {
+		return e.Filename < f.Filename
+	}
+	if e.Line != f.Line {
+		return e.Line < f.Line
+	}
+	if e.Column != f.Column {
+		return e.Column < f.Column
+	}
+	return p[i].Msg < p[j].Msg
+}
+
+// Sort sorts an ErrorList by position, then by error message.
+func (p ErrorList) Sort() {
+	sort.Sort(p)
+}
+
+// An ErrorList implements the error interface.
+func (p ErrorList) Error() string {
+	switch len(p) {
+	case 0:
+		return "no errors"
+	case 1:
+		return p[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", p[0], len(p)-1)
+}
+
+// Err returns an error equivalent to this error list.
+// If the list is empty, Err returns nil.
+func (p ErrorList) Err() error {
+	if len(p) == 0 {
+		return nil
+	}
+	return p
+}
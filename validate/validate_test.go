@@ -0,0 +1,572 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gqlc/graphql/ast"
+	"github.com/gqlc/graphql/parser"
+	"github.com/gqlc/graphql/token"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Srcs []string
+		Errs []string
+	}{
+		{
+			Name: "Valid",
+			Srcs: []string{`schema { query: Query, mutation: Mutation }
+
+type Query implements Node { id: ID!, search(term: String!, filter: Filter = {limit: 10}): [Result!]! }
+
+type Mutation { add(in: [Filter!]): Int }
+
+interface Node { id: ID! }
+
+union Result = Query | Mutation
+
+input Filter { limit: Int, kind: Kind }
+
+enum Kind { A, B }
+
+scalar Time
+
+directive @tag(name: String!) on FIELD_DEFINITION
+
+extend type Mutation { remove(id: ID!): Boolean }`},
+		},
+		{
+			Name: "MultipleDocuments",
+			Srcs: []string{
+				`type Query { a: A }`,
+				`type A { b: Int }
+extend type Query { c: A }`,
+			},
+		},
+		{
+			Name: "DuplicateTypes",
+			Srcs: []string{`type Query { a: Int }
+scalar A
+enum A { B }
+directive @d on FIELD
+directive @d on FIELD
+schema { query: Query }
+schema { query: Query }`},
+			Errs: []string{
+				"3:6 UniqueTypeNames: duplicate type: A",
+				"5:12 UniqueTypeNames: duplicate directive: @d",
+				"7:1 UniqueTypeNames: duplicate schema definition",
+			},
+		},
+		{
+			Name: "DuplicateTypesAcrossDocuments",
+			Srcs: []string{
+				`type Query { a: Int }`,
+				`type Query { b: Int }`,
+			},
+			Errs: []string{
+				"1:6 UniqueTypeNames: duplicate type: Query",
+			},
+		},
+		{
+			Name: "ReservedNames",
+			Srcs: []string{`type Query { __a(__b: Int): Int }
+type __T { a: Int }
+enum E { __V }
+input I { __f: Int }
+directive @__d on FIELD`},
+			Errs: []string{
+				"1:14 ReservedNames: reserved name: __a must not begin with \"__\"",
+				"1:18 ReservedNames: reserved name: __b must not begin with \"__\"",
+				"2:6 ReservedNames: reserved name: __T must not begin with \"__\"",
+				"3:10 ReservedNames: reserved name: __V must not begin with \"__\"",
+				"4:11 ReservedNames: reserved name: __f must not begin with \"__\"",
+				"5:12 ReservedNames: reserved name: __d must not begin with \"__\"",
+			},
+		},
+		{
+			Name: "UnknownTypes",
+			Srcs: []string{`type Query implements Node { a(b: [In!]): Out! }
+union U = A | Query
+input I { c: [[C]] }
+directive @d(e: E) on FIELD
+extend type T { a: Int }`},
+			Errs: []string{
+				"1:23 KnownTypes: unknown type: Node",
+				"1:36 KnownTypes: unknown type: In",
+				"1:43 KnownTypes: unknown type: Out",
+				"2:11 KnownTypes: unknown type: A",
+				"3:16 KnownTypes: unknown type: C",
+				"4:17 KnownTypes: unknown type: E",
+				"5:13 KnownTypes: cannot extend unknown type: T",
+			},
+		},
+		{
+			Name: "UnknownRootOperationType",
+			Srcs: []string{`schema { query: Q }`},
+			Errs: []string{
+				"1:17 KnownTypes: unknown type: Q",
+			},
+		},
+		{
+			Name: "ExtensionKindMismatch",
+			Srcs: []string{`type Query { a: Int }
+extend enum Query { B }`},
+			Errs: []string{
+				"2:13 KnownTypes: cannot extend type Query as enum",
+			},
+		},
+		{
+			Name: "DuplicateFields",
+			Srcs: []string{`type Query { a: Int, a: Int, b(c: Int, c: Int): Int }
+extend type Query { b: Int }
+enum E { A, A }
+input I { a: Int, a: Int }
+directive @d(a: Int, a: Int) on FIELD`},
+			Errs: []string{
+				"1:22 UniqueFieldNames: duplicate field: Query.a",
+				"1:40 UniqueFieldNames: duplicate argument: Query.b(c:)",
+				"2:21 UniqueFieldNames: duplicate field: Query.b",
+				"3:13 UniqueFieldNames: duplicate enum value: E.A",
+				"4:19 UniqueFieldNames: duplicate input field: I.a",
+				"5:22 UniqueFieldNames: duplicate argument: @d(a:)",
+			},
+		},
+		{
+			Name: "InputOutputTypes",
+			Srcs: []string{`type Query { a(b: Query): I, c: [I!] }
+input I { d: Query, e: E }
+enum E { A }
+directive @d(a: Query) on FIELD`},
+			Errs: []string{
+				"1:19 InputOutputTypes: argument Query.a(b:): Query is not an input type",
+				"1:27 InputOutputTypes: field Query.a: I is not an output type",
+				"1:34 InputOutputTypes: field Query.c: I is not an output type",
+				"2:14 InputOutputTypes: input field I.d: Query is not an input type",
+				"4:17 InputOutputTypes: argument @d(a:): Query is not an input type",
+			},
+		},
+		{
+			Name: "UnionMembers",
+			Srcs: []string{`type Query { a: Int }
+union U = Query | Query | E
+extend union U = Query
+enum E { A }`},
+			Errs: []string{
+				"2:19 UnionMembers: duplicate union member: U.Query",
+				"2:27 UnionMembers: union member U.E: E is not an object type",
+				"3:18 UnionMembers: duplicate union member: U.Query",
+			},
+		},
+		{
+			Name: "EmptyTypes",
+			Srcs: []string{`type Query { a: Int }
+type O
+interface I
+union U
+enum E
+input In
+type Extended
+extend type Extended { a: Int }`},
+			Errs: []string{
+				"2:6 NonEmptyTypes: type O must define one or more fields",
+				"3:11 NonEmptyTypes: interface I must define one or more fields",
+				"4:7 NonEmptyTypes: union U must have one or more member types",
+				"5:6 NonEmptyTypes: enum E must define one or more values",
+				"6:7 NonEmptyTypes: input In must define one or more fields",
+			},
+		},
+		{
+			Name: "MissingInterfaceField",
+			Srcs: []string{`type Query { a: Int }
+interface I { a: Int, b(x: Int): [I!] }
+type A implements I { a: Int! }`},
+			Errs: []string{
+				"3:19 InterfaceImplementations: type A does not implement I: missing field I.b (related 2:23)",
+			},
+		},
+		{
+			Name: "CovariantFieldTypes",
+			Srcs: []string{`type Query { a: Int }
+interface I { a: I, b: [I]!, c: U, d: Int!, e: [Int] }
+union U = A
+type A implements I { a: A!, b: [A!]!, c: A, d: Int, e: Int }`},
+			Errs: []string{
+				"4:49 InterfaceImplementations: field A.d: type Int is not a subtype of Int!, the type of I.d (related 2:36)",
+				"4:57 InterfaceImplementations: field A.e: type Int is not a subtype of [Int], the type of I.e (related 2:45)",
+			},
+		},
+		{
+			Name: "InterfaceFieldArguments",
+			Srcs: []string{`type Query { a: Int }
+interface I { f(a: Int, b: String!): Int }
+type A implements I { f(a: Int!, c: Int!, d: Int! = 1): Int }
+type B implements I { f(a: Int, b: String!, c: Int): Int }`},
+			Errs: []string{
+				"3:23 InterfaceImplementations: field A.f: missing argument I.f(b:) (related 2:25)",
+				"3:28 InterfaceImplementations: argument A.f(a:): type Int! does not match Int, the type of I.f(a:) (related 2:17)",
+				"3:34 InterfaceImplementations: argument A.f(c:): must be optional, because it is not an argument of I.f (related 2:15)",
+			},
+		},
+		{
+			Name: "ImplementedInterfaces",
+			Srcs: []string{`type Query { a: Int }
+interface N { id: ID }
+interface R implements N { id: ID }
+type A implements R { id: ID }
+interface S implements S { id: ID }
+type B implements Query { id: ID }
+type C implements N & N { id: ID }
+type D implements D { id: ID }`},
+			Errs: []string{
+				"4:19 InterfaceImplementations: type A must implement N, because it is implemented by R (related 3:24)",
+				"5:24 InterfaceImplementations: interface S cannot implement itself",
+				"6:19 InterfaceImplementations: type B cannot implement type Query",
+				"7:23 InterfaceImplementations: type C: duplicate interface: N",
+				"8:19 InterfaceImplementations: type D cannot implement itself",
+			},
+		},
+		{
+			Name: "ImplementedByExtension",
+			Srcs: []string{
+				`type Query { a: Int }
+interface I { a: Int, b: Int }
+type A { a: Int }`,
+				`extend type A implements I
+extend type A { b: Int }`,
+			},
+		},
+		{
+			Name: "Directives",
+			Srcs: []string{`type Query @unknown { a: Int @deprecated(reason: "old") @deprecated }
+scalar S @specifiedBy(url: "x") @specifiedBy(url: "y", u: 1)
+enum E @tag { A @deprecated(reason: 1) }
+directive @tag(name: String!, n: Int) repeatable on SCHEMA | ENUM | FIELD_DEFINITION
+input I @tag(name: "x") { a: [Int!] @deprecated, b: Int }
+extend enum E @tag(name: "a", name: "b")`},
+			Errs: []string{
+				"1:12 KnownDirectives: unknown directive: @unknown",
+				"1:57 KnownDirectives: directive @deprecated may only be used once at this location (related 1:30)",
+				"2:33 KnownDirectives: directive @specifiedBy may only be used once at this location (related 2:10)",
+				"2:56 KnownDirectives: unknown argument: @specifiedBy(u:)",
+				"3:8 KnownDirectives: missing required argument: @tag(name:)",
+				"3:37 KnownDirectives: argument @deprecated(reason:): cannot use 1 as String",
+				"5:9 KnownDirectives: directive @tag may not be used on INPUT_OBJECT",
+				"6:31 KnownDirectives: duplicate argument: @tag(name:)",
+			},
+		},
+		{
+			Name: "DirectiveArgumentCoercion",
+			Srcs: []string{`type Query { a: Int }
+directive @c(i: Int, f: Float, l: [Int!], n: In, e: E, id: ID) repeatable on SCHEMA
+input In { r: String!, o: [E] = [A] }
+enum E { A }
+schema @c(i: 1.5, f: 1, l: [1, null], n: {r: "x", x: 1}, e: B, id: 1) @c(l: 2, n: {o: A}, i: AB) @c(i: 3000000000, n: null, e: "A") { query: Query }`},
+			Errs: []string{
+				"5:14 KnownDirectives: argument @c(i:): cannot use 1.5 as Int",
+				"5:32 KnownDirectives: argument @c(l:): cannot use null as Int!",
+				"5:51 KnownDirectives: argument @c(n:): unknown field In.x",
+				"5:61 KnownDirectives: argument @c(e:): cannot use B as E",
+				"5:83 KnownDirectives: argument @c(n:): missing required field In.r",
+				"5:94 KnownDirectives: argument @c(i:): cannot use AB as Int",
+				"5:104 KnownDirectives: argument @c(i:): cannot use 3000000000 as Int",
+				"5:128 KnownDirectives: argument @c(e:): cannot use \"A\" as E",
+			},
+		},
+		{
+			Name: "DefaultValues",
+			Srcs: []string{`type Query { a(limit: Int = "ten", e: E = B, in: In = {b: 1}): Int }
+input In { a: Int!, b: [Int] = [1, 2], c: [E!] = A }
+enum E { A }
+directive @d(a: Boolean = null, b: Boolean! = null) on FIELD`},
+			Errs: []string{
+				"1:29 DefaultValues: default value of argument Query.a(limit:): cannot use \"ten\" as Int",
+				"1:43 DefaultValues: default value of argument Query.a(e:): cannot use B as E",
+				"1:55 DefaultValues: default value of argument Query.a(in:): missing required field In.a",
+				"4:47 DefaultValues: default value of argument @d(b:): cannot use null as Boolean!",
+			},
+		},
+		{
+			Name: "InputObjectCycles",
+			Srcs: []string{`type Query { a: Int }
+input A { b: B!, s: A }
+input B { a: A!, c: C!, l: [A!]! }
+input C { c: C! }
+input D { e: E! }
+input E { d: D }`},
+			Errs: []string{
+				"2:11 InputObjectCycles: input object A references itself through non-null fields: A.b -> B.a -> A (related 3:11)",
+				"4:11 InputObjectCycles: input object C references itself through non-null fields: C.c -> C",
+			},
+		},
+		{
+			Name: "InputObjectCyclesSharingTypes",
+			Srcs: []string{`type Query { a: Int }
+input A { b: B!, c: C! }
+input B { c: C! }
+input C { a: A! }`},
+			Errs: []string{
+				"2:11 InputObjectCycles: input object A references itself through non-null fields: A.b -> B.c -> C.a -> A (related 3:11) (related 4:11)",
+				"2:18 InputObjectCycles: input object A references itself through non-null fields: A.c -> C.a -> A (related 4:11)",
+			},
+		},
+		{
+			Name: "InputObjectCycleThroughExtension",
+			Srcs: []string{
+				`type Query { a: Int }
+input A { b: B }
+input B { c: Int }`,
+				`extend input B { a: A! }
+extend input A { c: B! }`,
+			},
+			Errs: []string{
+				"2:18 InputObjectCycles: input object A references itself through non-null fields: A.c -> B.a -> A (related 1:18)",
+			},
+		},
+		{
+			Name: "MissingQuery",
+			Srcs: []string{`type Mutation { a: Int }`},
+			Errs: []string{
+				"0:0 RootOperationTypes: missing query root operation type: no type named Query",
+			},
+		},
+		{
+			Name: "QueryNotObject",
+			Srcs: []string{`scalar Query`},
+			Errs: []string{
+				"1:8 RootOperationTypes: query root operation type Query must be an object type",
+			},
+		},
+		{
+			Name: "SchemaWithoutQuery",
+			Srcs: []string{`type Query { a: Int }
+enum E { A }
+schema { mutation: Query, subscription: E, foo: Query }
+extend schema { mutation: Query }`},
+			Errs: []string{
+				"3:1 RootOperationTypes: schema does not define the query root operation",
+				"3:41 RootOperationTypes: subscription root operation type E must be an object type",
+				"3:44 RootOperationTypes: unknown root operation: foo",
+				"4:17 RootOperationTypes: duplicate root operation: mutation",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			dset := token.NewDocSet()
+			docs := make([]*ast.Document, len(testCase.Srcs))
+			for i, src := range testCase.Srcs {
+				doc, err := parser.ParseDoc(dset, fmt.Sprintf("doc%d", i), strings.NewReader(src), 0)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				docs[i] = doc
+			}
+
+			err := Validate(dset, docs...)
+			if len(testCase.Errs) == 0 {
+				if err != nil {
+					subT.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			errs, ok := err.(ErrorList)
+			if !ok {
+				subT.Fatalf("expected ErrorList, got: %#v", err)
+			}
+
+			var got []string
+			for _, e := range errs {
+				msg := fmt.Sprintf("%d:%d %s: %s", e.Position.Line, e.Position.Column, e.Rule, e.Msg)
+				for _, p := range e.Related {
+					msg += fmt.Sprintf(" (related %d:%d)", p.Line, p.Column)
+				}
+				got = append(got, msg)
+			}
+			if strings.Join(got, "\n") != strings.Join(testCase.Errs, "\n") {
+				subT.Errorf("expected errors:\n%s\ngot:\n%s", strings.Join(testCase.Errs, "\n"), strings.Join(got, "\n"))
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	dset := token.NewDocSet()
+	doc, err := parser.ParseDoc(dset, "test", strings.NewReader(`type A { a: B }`), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Check(dset, []Rule{UniqueTypeNames, UniqueFieldNames}, doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = Check(dset, []Rule{KnownTypes}, doc)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if s := err.Error(); s != "test:1:13: unknown type: B" {
+		t.Fatalf("unexpected error message: %s", s)
+	}
+}
+
+func TestConfig(t *testing.T) {
+	dset := token.NewDocSet()
+	doc, err := parser.ParseDoc(dset, "test", strings.NewReader(`type Query { schema: __Schema, name(kind: __TypeKind = OBJECT): String @deprecated }`), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Validate(dset, doc)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if s := err.Error(); s != "test:1:22: unknown type: __Schema (and 1 more errors)" {
+		t.Fatalf("unexpected error message: %s", s)
+	}
+
+	cfg := &Config{Mode: Prelude}
+	if err = cfg.Validate(dset, doc); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cfg.Rules = []Rule{KnownTypes}
+	doc, err = parser.ParseDoc(dset, "test", strings.NewReader(`type A { a: B }`), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cfg.Validate(dset, doc); err == nil || err.Error() != "test:1:13: unknown type: B" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c := cfg.NewContext(dset)
+	if c.Types["__Type"] == nil || c.Types["Int"] == nil || c.Directives["deprecated"] == nil {
+		t.Error("expected context to index the prelude")
+	}
+}
+
+func TestCoerceLiteral(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Type  string
+		Value string
+		Err   string
+	}{
+		{Name: "Int", Type: "Int", Value: "1"},
+		{Name: "IntOverflow", Type: "Int", Value: "2147483648", Err: "cannot use 2147483648 as Int"},
+		{Name: "IntFromFloat", Type: "Int", Value: "1.0", Err: "cannot use 1.0 as Int"},
+		{Name: "FloatFromInt", Type: "Float", Value: "1"},
+		{Name: "String", Type: "String", Value: `"a"`},
+		{Name: "BlockString", Type: "String", Value: `"""a"""`},
+		{Name: "StringFromInt", Type: "String", Value: "1", Err: "cannot use 1 as String"},
+		{Name: "Boolean", Type: "Boolean", Value: "false"},
+		{Name: "IDFromInt", Type: "ID", Value: "1"},
+		{Name: "IDFromFloat", Type: "ID", Value: "1.5", Err: "cannot use 1.5 as ID"},
+		{Name: "CustomScalar", Type: "Time", Value: "{a: [1]}"},
+		{Name: "Null", Type: "Int", Value: "null"},
+		{Name: "NonNull", Type: "Int!", Value: "null", Err: "cannot use null as Int!"},
+		{Name: "Enum", Type: "Color", Value: "RED"},
+		{Name: "EnumFromExtension", Type: "Color", Value: "BLUE"},
+		{Name: "EnumFromString", Type: "Color", Value: `"RED"`, Err: `cannot use "RED" as Color`},
+		{Name: "UnknownEnumValue", Type: "Color", Value: "GREEN", Err: "cannot use GREEN as Color"},
+		{Name: "List", Type: "[Int]", Value: "[1, null, 3]"},
+		{Name: "SingleValueToList", Type: "[Int]", Value: "1"},
+		{Name: "NestedList", Type: "[[Int!]!]", Value: "[[1], 2]"},
+		{Name: "NullInNonNullList", Type: "[Int!]", Value: "[1, null]", Err: "cannot use null as Int!"},
+		{Name: "ListAsScalar", Type: "Int", Value: "[1]", Err: "cannot use list as Int"},
+		{Name: "InputObject", Type: "Point", Value: "{x: 1, y: 2.5}"},
+		{Name: "InputObjectDefault", Type: "Point", Value: "{x: 1}"},
+		{Name: "MissingInputField", Type: "Point", Value: "{y: 1}", Err: "missing required field Point.x"},
+		{Name: "UnknownInputField", Type: "Point", Value: "{x: 1, z: 1}", Err: "unknown field Point.z"},
+		{Name: "InputFieldType", Type: "Point", Value: `{x: "1"}`, Err: `cannot use "1" as Int`},
+		{Name: "ObjectAsList", Type: "[Point]", Value: "{x: 1}"},
+		{Name: "Variable", Type: "Int", Value: "$v", Err: "cannot use variable $v in a constant value"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			// variables can't be parsed in SDL, so they are substituted below
+			value := testCase.Value
+			if strings.HasPrefix(value, "$") {
+				value = "null"
+			}
+
+			src := fmt.Sprintf(`scalar Time
+enum Color { RED }
+extend enum Color { BLUE }
+input Point { x: Int!, y: Float = 0 }
+directive @test(arg: %s) on SCHEMA
+schema @test(arg: %s) { query: Point }`, testCase.Type, value)
+
+			dset := token.NewDocSet()
+			doc, err := parser.ParseDoc(dset, "test", strings.NewReader(src), 0)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			c := NewContext(dset, doc)
+			arg := c.Directives["test"].GetDirective().Args.List[0]
+			val := c.Schemas[0].GetTypeSpec().Directives[0].Args.Args[0]
+			if value != testCase.Value {
+				val.Value = &ast.Arg_Variable{Variable: &ast.Variable{
+					Name: &ast.Ident{Name: testCase.Value[1:]},
+				}}
+			}
+
+			err = c.CoerceLiteral(arg.Type, val.Value)
+			if testCase.Err == "" {
+				if err != nil {
+					subT.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				subT.Fatalf("expected error: %s", testCase.Err)
+			}
+			if err.Error() != testCase.Err {
+				subT.Fatalf("expected error: %s, got: %s", testCase.Err, err)
+			}
+			if _, ok := err.(*CoercionError); !ok {
+				subT.Fatalf("expected *CoercionError, got: %T", err)
+			}
+		})
+	}
+
+	t.Run("VariableDefault", func(subT *testing.T) {
+		dset := token.NewDocSet()
+		q, err := parser.ParseQuery(dset, "test", strings.NewReader(`query Q($a: [Int!] = [1, "2"], $b: Int = 1) { a }`), 0)
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		c := NewContext(dset)
+		vars := q.Definitions[0].GetOperation().Variables.List
+		err = c.CoerceLiteral(vars[0].Type, vars[0].Default)
+		if err == nil || err.Error() != `cannot use "2" as Int` {
+			subT.Errorf("unexpected error: %v", err)
+		}
+		if err = c.CoerceLiteral(vars[1].Type, vars[1].Default); err != nil {
+			subT.Errorf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Unsupported", func(subT *testing.T) {
+		c := NewContext(token.NewDocSet())
+		for _, val := range []interface{}{nil, "1"} {
+			err := c.CoerceLiteral(&ast.Ident{Name: "Int"}, val)
+			if _, ok := err.(*CoercionError); !ok {
+				subT.Fatalf("expected *CoercionError for %T, got: %v", val, err)
+			}
+			if msg := fmt.Sprintf("unsupported value of type %T", val); err.Error() != msg {
+				subT.Errorf("expected error: %s, got: %s", msg, err)
+			}
+		}
+	})
+}